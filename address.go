@@ -0,0 +1,291 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+// countryCodes holds the officially assigned ISO 3166-1 alpha-2 codes.
+var countryCodes = map[string]bool{}
+
+func init() {
+    for _, code := range strings.Fields(`
+        AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL
+        BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV
+        CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD
+        GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM
+        IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK
+        LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW
+        MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR
+        PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS
+        ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY
+        UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW`) {
+        countryCodes[code] = true
+    }
+}
+
+// postalCodePatterns maps country codes to the format of their postal
+// codes, matched against the upper-cased code.
+var postalCodePatterns = map[string]*regexp.Regexp{
+    "AU": regexp.MustCompile(`^[0-9]{4}$`),
+    "BR": regexp.MustCompile(`^[0-9]{5}-?[0-9]{3}$`),
+    "CA": regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z] ?[0-9][ABCEGHJ-NPRSTV-Z][0-9]$`),
+    "DE": regexp.MustCompile(`^[0-9]{5}$`),
+    "ES": regexp.MustCompile(`^(0[1-9]|[1-4][0-9]|5[0-2])[0-9]{3}$`),
+    "FR": regexp.MustCompile(`^[0-9]{5}$`),
+    "GB": regexp.MustCompile(`^(GIR ?0AA|[A-Z]{1,2}[0-9][A-Z0-9]? ?[0-9][A-Z]{2})$`),
+    "GH": regexp.MustCompile(`^[A-Z]{2}-?[0-9]{3,4}-?[0-9]{4}$`),
+    "IN": regexp.MustCompile(`^[1-9][0-9]{2} ?[0-9]{3}$`),
+    "IT": regexp.MustCompile(`^[0-9]{5}$`),
+    "JP": regexp.MustCompile(`^[0-9]{3}-?[0-9]{4}$`),
+    "KE": regexp.MustCompile(`^[0-9]{5}$`),
+    "NG": regexp.MustCompile(`^[0-9]{6}$`),
+    "NL": regexp.MustCompile(`^[1-9][0-9]{3} ?[A-Z]{2}$`),
+    "US": regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`),
+    "ZA": regexp.MustCompile(`^[0-9]{4}$`),
+}
+
+// genericPostalCodePattern is the check PostalCode applies to countries
+// without a known format: 2 to 10 letters and digits, possibly split by
+// single spaces or hyphens.
+var genericPostalCodePattern = regexp.MustCompile(`^[A-Z0-9]+([ -][A-Z0-9]+)*$`)
+
+// regionNames maps the countries whose regions are checked to their region
+// codes and names: the states, district, territories and military
+// addresses of the United States, and the provinces and territories of
+// Canada.
+var regionNames = map[string]map[string]string{
+    "US": {
+        "AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas", "CA": "California",
+        "CO": "Colorado", "CT": "Connecticut", "DE": "Delaware", "DC": "District of Columbia",
+        "FL": "Florida", "GA": "Georgia", "HI": "Hawaii", "ID": "Idaho", "IL": "Illinois",
+        "IN": "Indiana", "IA": "Iowa", "KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana",
+        "ME": "Maine", "MD": "Maryland", "MA": "Massachusetts", "MI": "Michigan",
+        "MN": "Minnesota", "MS": "Mississippi", "MO": "Missouri", "MT": "Montana",
+        "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire", "NJ": "New Jersey",
+        "NM": "New Mexico", "NY": "New York", "NC": "North Carolina", "ND": "North Dakota",
+        "OH": "Ohio", "OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania",
+        "RI": "Rhode Island", "SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee",
+        "TX": "Texas", "UT": "Utah", "VT": "Vermont", "VA": "Virginia", "WA": "Washington",
+        "WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+        "AS": "American Samoa", "GU": "Guam", "MP": "Northern Mariana Islands",
+        "PR": "Puerto Rico", "VI": "U.S. Virgin Islands", "UM": "U.S. Minor Outlying Islands",
+        "AA": "Armed Forces Americas", "AE": "Armed Forces Europe", "AP": "Armed Forces Pacific",
+    },
+    "CA": {
+        "AB": "Alberta", "BC": "British Columbia", "MB": "Manitoba", "NB": "New Brunswick",
+        "NL": "Newfoundland and Labrador", "NS": "Nova Scotia", "NT": "Northwest Territories",
+        "NU": "Nunavut", "ON": "Ontario", "PE": "Prince Edward Island", "QC": "Quebec",
+        "SK": "Saskatchewan", "YT": "Yukon",
+    },
+}
+
+// regionKinds names the regions of the countries in regionNames, for
+// error messages.
+var regionKinds = map[string]string{
+    "US": "a US state, such as NY",
+    "CA": "a Canadian province or territory, such as ON",
+}
+
+// CountryCode validates that the value is an ISO 3166-1 alpha-2 country
+// code, such as "NG" or "US", in any case, and converts the value to upper
+// case.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.CountryCode() // "ng" becomes "NG"
+func (f *Field) CountryCode(messages ...string) *Field {
+    f.addRule("countryCode", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        code := strings.ToUpper(str)
+        if !ok || !countryCodes[code] {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be an ISO 3166-1 country code, such as NG", f.name)
+        }
+        f.value = code
+        return nil
+    })
+    return f
+}
+
+// PostalCode validates that the value is a postal code of `country`, an
+// ISO 3166-1 alpha-2 code: "12345" or "12345-6789" for the US, "K1A 0B1"
+// for Canada, "SW1A 1AA" for the United Kingdom, and the formats of
+// Australia, Brazil, France, Germany, Ghana, India, Italy, Japan, Kenya,
+// the Netherlands, Nigeria, South Africa and Spain. Letters may be in any
+// case. Codes of other countries, or of an empty country, get a generic
+// check: letters and digits, possibly split by single spaces or hyphens,
+// 2 to 10 characters in all.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PostalCode("US")
+//    f.PostalCode(addr.Country, "Enter the postal code of your address")
+func (f *Field) PostalCode(country string, messages ...string) *Field {
+    country = strings.ToUpper(country)
+    pattern, known := postalCodePatterns[country]
+
+    f.addRule("postalCode", []interface{}{country}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        code := strings.ToUpper(str)
+        if ok && known {
+            ok = pattern.MatchString(code)
+        } else if ok {
+            ok = len(code) >= 2 && len(code) <= 10 && genericPostalCodePattern.MatchString(code)
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            if known {
+                return fmt.Errorf("%s must be a valid %s postal code", f.name, country)
+            }
+            return fmt.Errorf("%s must be a valid postal code", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// Region validates that the value is a region of `country`: a state,
+// district, territory or military address code of the US, such as "NY",
+// or a province or territory of Canada, such as "ON". Codes and full names
+// ("New York") are accepted in any case, and the value becomes the code.
+// Regions of other countries are not checked, so any value passes.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Region("US") // "california" becomes "CA"
+//    f.Region(addr.Country)
+func (f *Field) Region(country string, messages ...string) *Field {
+    country = strings.ToUpper(country)
+    regions := regionNames[country]
+
+    f.addRule("region", []interface{}{country}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok && regions == nil {
+            return nil
+        }
+
+        code := ""
+        if ok {
+            code = regionCode(regions, str)
+        }
+        if code == "" {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            if regions == nil {
+                return mismatch(f, "a string")
+            }
+            return fmt.Errorf("%s must be %s", f.name, regionKinds[country])
+        }
+        f.value = code
+        return nil
+    })
+    return f
+}
+
+// regionCode returns the code of the region named `str`, by its code or
+// its name in any case, or "" when it is not one of `regions`.
+func regionCode(regions map[string]string, str string) string {
+    str = strings.TrimSpace(str)
+    if _, ok := regions[strings.ToUpper(str)]; ok {
+        return strings.ToUpper(str)
+    }
+    for code, name := range regions {
+        if strings.EqualFold(name, str) {
+            return code
+        }
+    }
+    return ""
+}
+
+// AddressInput is a postal address to validate with Validator.Address.
+type AddressInput struct {
+    Line1      string
+    Line2      string
+    City       string
+    Region     string
+    PostalCode string
+    // Country is an ISO 3166-1 alpha-2 code, such as "US".
+    Country string
+}
+
+// AddressOpts configures Validator.Address. Line 1 and the city are always
+// required; the other parts are optional unless required here.
+type AddressOpts struct {
+    // Country, when set, is the only country accepted, and the country
+    // used to check the region and postal code when the address has none.
+    Country string
+    // RequireRegion requires the region, such as the state.
+    RequireRegion bool
+    // RequirePostalCode requires the postal code.
+    RequirePostalCode bool
+    // RequireCountry requires the country of the address, even when
+    // opts.Country is set.
+    RequireCountry bool
+}
+
+// Address registers the parts of a postal address as fields named after
+// `name`, such as "Shipping Address.Postal Code":
+//   - Line 1 and City are required, and at most 200 and 100 bytes long,
+//   - Line 2 is optional, and at most 200 bytes long,
+//   - Country must pass CountryCode, and be opts.Country when it is set,
+//   - Region must pass Region and Postal Code must pass PostalCode for the
+//     country of the address, or opts.Country when it has none.
+//
+// Region, Postal Code and Country are optional unless opts requires them.
+//
+// Example:
+//    v.Address(validator.AddressInput{
+//        Line1: req.Street, City: req.City, Region: req.State,
+//        PostalCode: req.Zip, Country: req.Country,
+//    }, "Shipping Address", validator.AddressOpts{RequirePostalCode: true})
+//    // Shipping Address.Postal Code must be a valid US postal code
+func (v *Validator) Address(addr AddressInput, name string, opts AddressOpts) *Validator {
+    country := strings.ToUpper(addr.Country)
+    if country == "" {
+        country = strings.ToUpper(opts.Country)
+    }
+
+    return v.Scope(name, func(sv *Validator) {
+        sv.Field(addr.Line1, "Line 1").RequiredNonBlank().MaxLength(200)
+        sv.Field(addr.Line2, "Line 2").Optional().MaxLength(200)
+        sv.Field(addr.City, "City").RequiredNonBlank().MaxLength(100)
+        requiredOrOptional(sv.Field(addr.Region, "Region"), opts.RequireRegion).Region(country)
+        requiredOrOptional(sv.Field(addr.PostalCode, "Postal Code"), opts.RequirePostalCode).PostalCode(country)
+
+        countryField := requiredOrOptional(sv.Field(addr.Country, "Country"), opts.RequireCountry).CountryCode()
+        if opts.Country != "" {
+            countryField.OneOf([]interface{}{strings.ToUpper(opts.Country)}, fmt.Sprintf("%sCountry must be %s", sv.prefix, strings.ToUpper(opts.Country)))
+        }
+    })
+}
+
+// requiredOrOptional makes f required or optional.
+func requiredOrOptional(f *Field, required bool) *Field {
+    if required {
+        return f.RequiredNonBlank()
+    }
+    return f.Optional()
+}
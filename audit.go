@@ -0,0 +1,141 @@
+package validator
+
+// RuleStatus is the outcome of a rule in an audit record.
+type RuleStatus string
+
+const (
+    // RulePassed means the rule was checked and the value satisfied it.
+    RulePassed RuleStatus = "passed"
+    // RuleFailed means the rule was checked and reported an error or a
+    // warning, or its lookup failed and aborted validation.
+    RuleFailed RuleStatus = "failed"
+    // RuleSkipped means the rule was not checked: its field was empty and
+    // optional, absent, or had a value that could not be resolved, or
+    // validation stopped before reaching it. RuleOutcome.Reason tells which.
+    RuleSkipped RuleStatus = "skipped"
+)
+
+// SkipReason tells why a rule was skipped in an audit record.
+type SkipReason string
+
+const (
+    // SkipAbsent means the field was absent from the input (see FieldMaybe
+    // and IfPresent).
+    SkipAbsent SkipReason = "field absent"
+    // SkipEmpty means the value was empty and the field optional (see
+    // Optional and AllowEmpty).
+    SkipEmpty SkipReason = "value empty and field optional"
+    // SkipUnresolved means the value could not be computed, such as when the
+    // supplier of a FieldFunc panicked.
+    SkipUnresolved SkipReason = "value could not be resolved"
+    // SkipDuplicate means another field with the same key was registered
+    // first (see ForbidDuplicateFieldNames).
+    SkipDuplicate SkipReason = "field registered more than once"
+    // SkipNotList means the rule came after Each and the value was not a
+    // list.
+    SkipNotList SkipReason = "value is not a list"
+    // SkipStopped means validation stopped at the error limit, such as
+    // after the first error with stopOnFirst, before reaching the rule.
+    SkipStopped SkipReason = "error limit reached"
+    // SkipAborted means validation was aborted before reaching the rule,
+    // by a failed lookup, the context or misconfigured rules (see
+    // Result.Err).
+    SkipAborted SkipReason = "validation aborted"
+)
+
+// RuleOutcome records what happened to one rule of one field during a
+// validation run in audit mode (see Audit). Rules after Each are recorded
+// once per item, with the item's key, such as "tags[1]". It marshals to
+// JSON with lowercase keys, so the audit trail can be stored alongside the
+// submission.
+type RuleOutcome struct {
+    Field   string     `json:"field"`
+    Rule    string     `json:"rule"`
+    Status  RuleStatus `json:"status"`
+    Warning bool       `json:"warning,omitempty"`
+    // Message is the error message of a failed rule. Values of sensitive
+    // fields are redacted as in the errors.
+    Message string `json:"message,omitempty"`
+    // Reason tells why a skipped rule was not checked.
+    Reason SkipReason `json:"reason,omitempty"`
+}
+
+// Audit controls whether a validation run records the outcome of every
+// registered rule, including the rules that were skipped, for audit trails
+// proving which checks ran on a submission. The record is returned by
+// Result.Audit, so use ValidateN to get it. It is off by default, and
+// nothing is recorded then.
+//
+// Example:
+//
+//    v := validator.New().Audit(true)
+//    ...
+//    res := v.ValidateN(0)
+//    record, _ := json.Marshal(res.Audit())
+func (v *Validator) Audit(enabled bool) *Validator {
+    v.root().audit = enabled
+    return v.Invalidate()
+}
+
+// Audit returns the outcome of every rule of every field, in the order the
+// rules were registered, when the validator is in audit mode, or nil.
+func (r *Result) Audit() []RuleOutcome {
+    return r.outcomes
+}
+
+// Skipped returns the outcomes of the rules that were not checked, with the
+// reason of each, when the validator is in audit mode, or nil. It answers
+// "why didn't this rule fire?". There is no "condition false" reason, since
+// the package has no When or Unless: a rule that only applies under a
+// condition is registered under that condition, and is not recorded when
+// it doesn't hold.
+//
+// Example:
+//
+//    for _, o := range v.Audit(true).ValidateN(0).Skipped() {
+//        log.Printf("%s: %s skipped: %s", o.Field, o.Rule, o.Reason)
+//    }
+func (r *Result) Skipped() []RuleOutcome {
+    var skipped []RuleOutcome
+    for _, outcome := range r.outcomes {
+        if outcome.Status == RuleSkipped {
+            skipped = append(skipped, outcome)
+        }
+    }
+    return skipped
+}
+
+// record adds the outcome of rule r on f to the audit record. err is the
+// error the rule reported, or nil when it passed.
+func (res *Result) record(f *Field, r *rule, err error) {
+    if !res.audit {
+        return
+    }
+
+    outcome := RuleOutcome{Field: f.fieldKey(), Rule: r.name, Status: RulePassed, Warning: r.warning}
+    if err != nil {
+        outcome.Status = RuleFailed
+        outcome.Message = err.Error()
+    }
+    res.outcomes = append(res.outcomes, outcome)
+}
+
+// skip adds `rules` of f to the audit record as skipped for `reason`.
+func (res *Result) skip(f *Field, rules []*rule, reason SkipReason) {
+    if !res.audit {
+        return
+    }
+
+    for _, r := range rules {
+        res.outcomes = append(res.outcomes, RuleOutcome{Field: f.fieldKey(), Rule: r.name, Status: RuleSkipped, Warning: r.warning, Reason: reason})
+    }
+}
+
+// stopReason returns why the rules left when validation stopped early were
+// skipped: it was aborted, or the error limit was reached.
+func (res *Result) stopReason() SkipReason {
+    if res.err != nil {
+        return SkipAborted
+    }
+    return SkipStopped
+}
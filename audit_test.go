@@ -0,0 +1,52 @@
+package validator
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestSkipped(t *testing.T) {
+    tests := []struct {
+        name  string
+        build func(v *Validator)
+        limit int
+        want  []RuleOutcome
+    }{
+        {"absent", func(v *Validator) {
+            v.FieldMaybe(nil, false, "Nickname").IfPresent().MinRunes(3).MaxRunes(20)
+        }, 0, []RuleOutcome{
+            {Field: "Nickname", Rule: "minRunes", Status: RuleSkipped, Reason: SkipAbsent},
+            {Field: "Nickname", Rule: "maxRunes", Status: RuleSkipped, Reason: SkipAbsent},
+        }},
+        {"empty", func(v *Validator) {
+            v.Field("", "Nickname").Optional().MinRunes(3).MaxRunes(20)
+        }, 0, []RuleOutcome{
+            {Field: "Nickname", Rule: "minRunes", Status: RuleSkipped, Reason: SkipEmpty},
+            {Field: "Nickname", Rule: "maxRunes", Status: RuleSkipped, Reason: SkipEmpty},
+        }},
+        {"stopped", func(v *Validator) {
+            v.Field("x", "Nickname").MinRunes(3).MaxRunes(20)
+        }, 1, []RuleOutcome{
+            {Field: "Nickname", Rule: "maxRunes", Status: RuleSkipped, Reason: SkipStopped},
+        }},
+        {"checked", func(v *Validator) {
+            v.Field("Ada", "Nickname").MinRunes(3).MaxRunes(20)
+        }, 0, nil},
+    }
+
+    for _, tt := range tests {
+        v := New().Audit(true)
+        tt.build(v)
+        res := v.ValidateN(tt.limit)
+        if got := res.Skipped(); !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("%s: Skipped() = %+v, want %+v", tt.name, got, tt.want)
+        }
+    }
+
+    // Nothing is recorded outside audit mode.
+    v := New()
+    v.Field("", "Nickname").Optional().MinRunes(3)
+    if got := v.ValidateN(0).Skipped(); got != nil {
+        t.Errorf("Skipped() without audit = %+v", got)
+    }
+}
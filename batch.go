@@ -0,0 +1,280 @@
+package validator
+
+import (
+    "context"
+    "errors"
+    "sync"
+)
+
+// ErrBatchErrorLimit is the BatchResult.Err of a batch stopped because
+// it reached BatchOpts.MaxErrors.
+var ErrBatchErrorLimit = errors.New("validator: batch stopped at its error limit")
+
+// BatchOpts configures Schema.ValidateBatchWith.
+type BatchOpts struct {
+    // Workers is the number of records validated in parallel. 0 and 1
+    // validate them one at a time, on the calling goroutine.
+    Workers int
+    // MaxErrors, when above 0, stops the batch once that many errors were
+    // passed to the sink, such as an import that is not worth finishing.
+    // The record that reaches the limit is still passed to the sink.
+    MaxErrors int
+    // StopOnFirst reports only the first error of each record.
+    StopOnFirst bool
+}
+
+// BatchResult summarizes a run of Schema.ValidateBatch.
+type BatchResult struct {
+    // Rows is the number of records validated and passed on to the sink
+    // when they had errors.
+    Rows int
+    // InvalidRows is the number of records that had errors.
+    InvalidRows int
+    // Errors is the number of errors passed to the sink.
+    Errors int
+    // Err is why the batch stopped before the iterator was done: ctx's
+    // error, ErrBatchErrorLimit, or a failure that is not the input's
+    // fault, as returned by Validator.ValidateContext. It is nil when
+    // every record was validated.
+    Err error
+}
+
+// ValidateBatch validates a stream of decoded records against the schema,
+// such as the rows of an import, without keeping them: `iter` returns the
+// next record and true, or false when there are no more, and `sink` is
+// called with the index of each record that has errors, from 0, and its
+// errors. Records are validated like ValidateMap, but the fields and
+// buffers are reused from one record to the next, so memory stays flat
+// however many records there are. The errors slice is reused after sink
+// returns, so sink must copy what it keeps. Validation stops when ctx is
+// done.
+//
+// Example:
+//
+//    res := importSchema.ValidateBatch(ctx, func() (map[string]interface{}, bool) {
+//        var row map[string]interface{}
+//        if err := dec.Decode(&row); err != nil {
+//            return nil, false
+//        }
+//        return row, true
+//    }, func(row int, errs []validator.ValidationError) {
+//        report.Add(row, errs[0].Message)
+//    })
+func (s *Schema) ValidateBatch(ctx context.Context, iter func() (map[string]interface{}, bool), sink func(row int, errs []ValidationError)) BatchResult {
+    return s.ValidateBatchWith(ctx, BatchOpts{}, iter, sink)
+}
+
+// ValidateBatchWith is ValidateBatch with options: parallel workers, an
+// error budget that stops the batch early, and only the first error of
+// each record. With several workers, iter is still called from a single
+// goroutine, and sink from the calling goroutine in the order of the
+// records, at most 2 × Workers records being in flight at a time.
+//
+// Example:
+//
+//    res := importSchema.ValidateBatchWith(ctx, validator.BatchOpts{Workers: 8, MaxErrors: 1000}, next, report)
+//    if errors.Is(res.Err, validator.ErrBatchErrorLimit) {
+//        log.Printf("import stopped after %d rows", res.Rows)
+//    }
+func (s *Schema) ValidateBatchWith(ctx context.Context, opts BatchOpts, iter func() (map[string]interface{}, bool), sink func(row int, errs []ValidationError)) BatchResult {
+    if opts.Workers <= 1 {
+        return s.validateSerial(ctx, opts, iter, sink)
+    }
+    return s.validateParallel(ctx, opts, iter, sink)
+}
+
+// validateSerial runs a batch on the calling goroutine.
+func (s *Schema) validateSerial(ctx context.Context, opts BatchOpts, iter func() (map[string]interface{}, bool), sink func(row int, errs []ValidationError)) BatchResult {
+    var res BatchResult
+    w := newBatchWorker(s)
+    for row := 0; ; row++ {
+        if err := ctx.Err(); err != nil {
+            res.Err = err
+            return res
+        }
+        data, ok := iter()
+        if !ok {
+            return res
+        }
+
+        errs, err := w.validate(ctx, data, opts.StopOnFirst)
+        if err != nil {
+            res.Err = err
+            return res
+        }
+        if res.deliver(row, errs, opts, sink) {
+            return res
+        }
+    }
+}
+
+// batchJob is a record waiting for a worker.
+type batchJob struct {
+    row  int
+    data map[string]interface{}
+}
+
+// batchOutcome is the result of a record validated by a worker.
+type batchOutcome struct {
+    row  int
+    errs []ValidationError
+    err  error
+}
+
+// validateParallel runs a batch on opts.Workers goroutines, passing the
+// results to sink in the order of the records.
+func (s *Schema) validateParallel(ctx context.Context, opts BatchOpts, iter func() (map[string]interface{}, bool), sink func(row int, errs []ValidationError)) BatchResult {
+    jobs := make(chan batchJob)
+    outcomes := make(chan batchOutcome, opts.Workers)
+    // slots bounds the records read but not yet passed to sink.
+    slots := make(chan struct{}, 2*opts.Workers)
+    done := make(chan struct{})
+
+    var res BatchResult
+    go func() {
+        defer close(jobs)
+        for row := 0; ; row++ {
+            select {
+            case slots <- struct{}{}:
+            case <-done:
+                return
+            }
+            if err := ctx.Err(); err != nil {
+                outcomes <- batchOutcome{row: row, err: err}
+                return
+            }
+            data, ok := iter()
+            if !ok {
+                return
+            }
+            select {
+            case jobs <- batchJob{row, data}:
+            case <-done:
+                return
+            }
+        }
+    }()
+
+    var wg sync.WaitGroup
+    for i := 0; i < opts.Workers; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            w := newBatchWorker(s)
+            for job := range jobs {
+                errs, err := w.validate(ctx, job.data, opts.StopOnFirst)
+                // The buffer of w is reused for the next record, while
+                // these errors may wait for earlier records.
+                outcomes <- batchOutcome{job.row, append([]ValidationError(nil), errs...), err}
+            }
+        }()
+    }
+    go func() {
+        // The reader sends its last outcome before closing jobs, so every
+        // sender is done once the workers are.
+        wg.Wait()
+        close(outcomes)
+    }()
+
+    pending := map[int]batchOutcome{}
+    next := 0
+    stopped := false
+    for outcome := range outcomes {
+        if stopped {
+            continue
+        }
+        pending[outcome.row] = outcome
+        for {
+            current, ok := pending[next]
+            if !ok {
+                break
+            }
+            delete(pending, next)
+            next++
+            <-slots
+
+            if current.err != nil {
+                res.Err = current.err
+            } else if !res.deliver(current.row, current.errs, opts, sink) {
+                continue
+            }
+            stopped = true
+            close(done)
+            break
+        }
+    }
+    return res
+}
+
+// deliver passes the errors of a record to sink and counts them. It
+// reports whether the batch must stop at the error limit.
+func (res *BatchResult) deliver(row int, errs []ValidationError, opts BatchOpts, sink func(row int, errs []ValidationError)) bool {
+    res.Rows++
+    if len(errs) == 0 {
+        return false
+    }
+    res.InvalidRows++
+    res.Errors += len(errs)
+    sink(row, errs)
+    if opts.MaxErrors > 0 && res.Errors >= opts.MaxErrors {
+        res.Err = ErrBatchErrorLimit
+        return true
+    }
+    return false
+}
+
+// batchWorker validates records one after the other, reusing its
+// validator, fields and error buffer.
+type batchWorker struct {
+    schema *Schema
+    v      *Validator
+    fields []*Field
+    used   int
+    errs   []ValidationError
+}
+
+// newBatchWorker creates a worker validating records against s.
+func newBatchWorker(s *Schema) *batchWorker {
+    return &batchWorker{schema: s, v: New()}
+}
+
+// validate validates one record. The errors are valid until the next call.
+func (w *batchWorker) validate(ctx context.Context, data map[string]interface{}, stopOnFirst bool) ([]ValidationError, error) {
+    w.v.fields = w.v.fields[:0]
+    w.used = 0
+    w.schema.bindMap(w.v, data, w.bind)
+    w.v.Invalidate()
+
+    errs, err := w.v.ValidateContext(ctx, stopOnFirst)
+    if err != nil {
+        return nil, err
+    }
+
+    w.errs = w.errs[:0]
+    for _, err := range errs {
+        var verr ValidationError
+        if !errors.As(err, &verr) {
+            verr = ValidationError{Message: err.Error(), Err: err}
+        }
+        w.errs = append(w.errs, verr)
+    }
+    return w.errs, nil
+}
+
+// bind registers a copy of the schema field def on w.v, holding `value`,
+// reusing a field of an earlier record when there is one.
+func (w *batchWorker) bind(def *Field, v *Validator, value interface{}) *Field {
+    if w.used == len(w.fields) {
+        w.fields = append(w.fields, new(Field))
+    }
+    f := w.fields[w.used]
+    w.used++
+
+    *f = *def
+    f.rules = def.rules[:len(def.rules):len(def.rules)]
+    f.validator = v
+    f.raw = value
+    f.value = value
+    v.fields = append(v.fields, f)
+    return f
+}
@@ -0,0 +1,152 @@
+package validator
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "reflect"
+    "testing"
+)
+
+// batchSchema validates the records of batchRows.
+func batchSchema() *Schema {
+    return NewSchema(func(s *Schema) {
+        s.Field("email", "Email").Required().Email()
+        s.Field("age", "Age").Required().Number().Min(18)
+        s.Field("items.*.sku", "SKU").Required().String()
+    })
+}
+
+// batchRows returns an iterator over n records. Every tenth record, from
+// the fourth, has a bad email, and every 25th also a bad age.
+func batchRows(n int) func() (map[string]interface{}, bool) {
+    i := 0
+    return func() (map[string]interface{}, bool) {
+        if i == n {
+            return nil, false
+        }
+        row := map[string]interface{}{
+            "email": "ada@example.com",
+            "age":   float64(36),
+            "items": []interface{}{map[string]interface{}{"sku": "A-1"}},
+        }
+        if i%10 == 3 {
+            row["email"] = "not an email"
+        }
+        if i%25 == 0 {
+            row["age"] = float64(12)
+        }
+        i++
+        return row, true
+    }
+}
+
+// batchReport is what a sink received.
+type batchReport struct {
+    rows     []int
+    messages []string
+}
+
+func (r *batchReport) sink(row int, errs []ValidationError) {
+    r.rows = append(r.rows, row)
+    for _, err := range errs {
+        r.messages = append(r.messages, fmt.Sprintf("%d: %s", row, err.Message))
+    }
+}
+
+func TestValidateBatchOrder(t *testing.T) {
+    var report batchReport
+    res := batchSchema().ValidateBatchWith(context.Background(), BatchOpts{Workers: 8}, batchRows(10000), report.sink)
+    if res.Err != nil {
+        t.Fatal(res.Err)
+    }
+
+    for i := 1; i < len(report.rows); i++ {
+        if report.rows[i] <= report.rows[i-1] {
+            t.Fatalf("row %d passed to the sink after row %d", report.rows[i], report.rows[i-1])
+        }
+    }
+    if res.Rows != 10000 || res.InvalidRows != len(report.rows) {
+        t.Errorf("got %+v for %d invalid rows", res, len(report.rows))
+    }
+}
+
+func TestValidateBatchParallelMatchesSerial(t *testing.T) {
+    for _, opts := range []BatchOpts{{}, {StopOnFirst: true}, {MaxErrors: 50}} {
+        var serial batchReport
+        serialRes := batchSchema().ValidateBatchWith(context.Background(), opts, batchRows(5000), serial.sink)
+
+        for _, workers := range []int{2, 8} {
+            parallelOpts := opts
+            parallelOpts.Workers = workers
+            var parallel batchReport
+            parallelRes := batchSchema().ValidateBatchWith(context.Background(), parallelOpts, batchRows(5000), parallel.sink)
+
+            if !reflect.DeepEqual(parallelRes, serialRes) {
+                t.Errorf("%+v: got %+v, serially %+v", parallelOpts, parallelRes, serialRes)
+            }
+            if !reflect.DeepEqual(parallel, serial) {
+                t.Errorf("%+v: the sink received other errors than serially", parallelOpts)
+            }
+        }
+    }
+}
+
+func TestValidateBatchErrorLimit(t *testing.T) {
+    for _, workers := range []int{0, 4} {
+        var report batchReport
+        res := batchSchema().ValidateBatchWith(context.Background(), BatchOpts{Workers: workers, MaxErrors: 5}, batchRows(1000), report.sink)
+
+        if !errors.Is(res.Err, ErrBatchErrorLimit) {
+            t.Errorf("workers %d: Err = %v, want ErrBatchErrorLimit", workers, res.Err)
+        }
+        // Rows 0, 3, 13, 23 and 25 have an error each, so the limit is
+        // reached at row 25.
+        want := BatchResult{Rows: 26, InvalidRows: 5, Errors: 5, Err: ErrBatchErrorLimit}
+        if res != want {
+            t.Errorf("workers %d: got %+v, want %+v", workers, res, want)
+        }
+        if !reflect.DeepEqual(report.rows, []int{0, 3, 13, 23, 25}) {
+            t.Errorf("workers %d: sink received rows %v", workers, report.rows)
+        }
+    }
+}
+
+func TestValidateBatchStops(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    for _, workers := range []int{0, 4} {
+        res := batchSchema().ValidateBatchWith(ctx, BatchOpts{Workers: workers}, batchRows(1000), func(int, []ValidationError) {})
+        if !errors.Is(res.Err, context.Canceled) {
+            t.Errorf("workers %d: Err = %v, want context.Canceled", workers, res.Err)
+        }
+    }
+
+    bad := NewSchema(func(s *Schema) {
+        s.Field("age", "Age").Int64Between(120, 18)
+    })
+    for _, workers := range []int{0, 4} {
+        res := bad.ValidateBatchWith(context.Background(), BatchOpts{Workers: workers}, batchRows(1000), func(int, []ValidationError) {
+            t.Error("a misconfigured schema passed errors to the sink")
+        })
+        var cfgErr *ConfigError
+        if !errors.As(res.Err, &cfgErr) || res.Rows != 0 {
+            t.Errorf("workers %d: got %+v, want a *ConfigError", workers, res)
+        }
+    }
+}
+
+func BenchmarkValidateBatch1M(b *testing.B) {
+    s := batchSchema()
+    for _, workers := range []int{0, 8} {
+        b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+            b.ReportAllocs()
+            for b.Loop() {
+                res := s.ValidateBatchWith(context.Background(), BatchOpts{Workers: workers}, batchRows(1_000_000), func(int, []ValidationError) {})
+                if res.Err != nil || res.Rows != 1_000_000 {
+                    b.Fatalf("got %+v", res)
+                }
+            }
+        })
+    }
+}
@@ -0,0 +1,95 @@
+package validator
+
+import (
+    "fmt"
+    "strconv"
+)
+
+// BBoxOpts configures Validator.BoundingBox.
+type BBoxOpts struct {
+    // AllowAntimeridian accepts boxes crossing the antimeridian, whose west
+    // longitude is greater than their east longitude, such as 170 to -170
+    // around Fiji. By default they are rejected as inverted.
+    AllowAntimeridian bool
+}
+
+// BoundingBox registers the four corners of a bounding box, such as the
+// visible area of a map search, as fields named after `name`, such as
+// "Map Area.South Latitude", and checks that:
+//   - each is a number, the latitudes between -90 and 90 and the
+//     longitudes between -180 and 180,
+//   - the south latitude is less than the north latitude,
+//   - the west longitude is less than the east longitude, unless
+//     opts.AllowAntimeridian accepts boxes crossing the antimeridian.
+//
+// The corners may be integers, floats, json.Numbers or plain decimal
+// strings such as "6.5244", as sent in query strings. The errors tell
+// which corner or relationship is wrong. The returned field is the check
+// of the relationships, for WithCode or Warn.
+//
+// Example:
+//    q := r.URL.Query()
+//    v.BoundingBox(q.Get("min_lat"), q.Get("min_lng"), q.Get("max_lat"), q.Get("max_lng"), "Map Area", validator.BBoxOpts{})
+//    // Map Area south latitude must be less than its north latitude
+func (v *Validator) BoundingBox(minLat, minLng, maxLat, maxLng interface{}, name string, opts BBoxOpts) *Field {
+    v.Scope(name, func(sv *Validator) {
+        sv.Field(minLat, "South Latitude").addRule("bboxCorner", []interface{}{90}, checkCorner(90))
+        sv.Field(minLng, "West Longitude").addRule("bboxCorner", []interface{}{180}, checkCorner(180))
+        sv.Field(maxLat, "North Latitude").addRule("bboxCorner", []interface{}{90}, checkCorner(90))
+        sv.Field(maxLng, "East Longitude").addRule("bboxCorner", []interface{}{180}, checkCorner(180))
+    })
+
+    return v.CheckComputed(name, func() error {
+        south, southOK := coordinate(minLat, 90)
+        west, westOK := coordinate(minLng, 180)
+        north, northOK := coordinate(maxLat, 90)
+        east, eastOK := coordinate(maxLng, 180)
+        if !southOK || !westOK || !northOK || !eastOK {
+            // Already reported by the corner that failed.
+            return nil
+        }
+
+        if south >= north {
+            return fmt.Errorf("%s south latitude must be less than its north latitude", name)
+        }
+        switch {
+        case west == east:
+            return fmt.Errorf("%s west longitude must differ from its east longitude", name)
+        case west > east && !opts.AllowAntimeridian:
+            return fmt.Errorf("%s west longitude must be less than its east longitude, as boxes crossing the antimeridian are not accepted", name)
+        }
+        return nil
+    })
+}
+
+// checkCorner returns the rule checking a corner of a bounding box, a
+// number between -limit and limit.
+func checkCorner(limit float64) func(f *Field) error {
+    return func(f *Field) error {
+        if isEmpty(f.value) {
+            return fmt.Errorf("%s is required", f.name)
+        }
+        if _, ok := coordinate(f.value, 0); !ok {
+            return fmt.Errorf("%s must be a number", f.name)
+        }
+        if _, ok := coordinate(f.value, limit); !ok {
+            return fmt.Errorf("%s must be between %v and %v", f.name, -limit, limit)
+        }
+        return nil
+    }
+}
+
+// coordinate returns value as a number between -limit and limit, or any
+// number when limit is 0. Strings must be plain decimals.
+func coordinate(value interface{}, limit float64) (float64, bool) {
+    n, ok := toFloat64(value)
+    if str, isText := numericText(value); isText {
+        var err error
+        n, err = strconv.ParseFloat(str, 64)
+        ok = err == nil && decimalPattern.MatchString(str)
+    }
+    if !ok || (limit > 0 && (n < -limit || n > limit)) {
+        return 0, false
+    }
+    return n, true
+}
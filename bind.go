@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// BindOption configures how BindJSON decodes the request body.
+type BindOption func(*bindConfig)
+
+// bindConfig holds the options of a BindJSON call.
+type bindConfig struct {
+    disallowUnknown bool
+}
+
+// DisallowUnknownFields makes BindJSON report keys that do not match
+// a field of the destination struct.
+func DisallowUnknownFields() BindOption {
+    return func(c *bindConfig) {
+        c.disallowUnknown = true
+    }
+}
+
+// BindJSON decodes a JSON body into dst and then validates it with the rules
+// registered by `build`.
+//
+// The two results keep the kinds of failure apart:
+//   - the error is non-nil when the body is not valid JSON at all, such as
+//     "invalid JSON at offset 42" or an empty body; nothing is validated
+//   - the []error holds problems with the content, as ValidationErrors:
+//     a value of the wrong type ("age must be a number"), an unknown key
+//     when DisallowUnknownFields is set, or a failing rule
+// When a value has the wrong type or a key is unknown, only that problem is
+// reported and `build` is not run, since dst is not fully decoded.
+//
+// Example:
+//
+//    var req SignupRequest
+//    errs, err := validator.BindJSON(r.Body, &req, func(v *validator.Validator, dst interface{}) {
+//        req := dst.(*SignupRequest)
+//        v.Field(req.Email, "Email").Required().Email()
+//    }, validator.DisallowUnknownFields())
+//    if err != nil {
+//        // 400 Bad Request
+//    }
+//    if errs != nil {
+//        // 422 Unprocessable Entity
+//    }
+func BindJSON(r io.Reader, dst interface{}, build func(v *Validator, dst interface{}), opts ...BindOption) ([]error, error) {
+    config := &bindConfig{}
+    for _, opt := range opts {
+        opt(config)
+    }
+
+    dec := json.NewDecoder(r)
+    if config.disallowUnknown {
+        dec.DisallowUnknownFields()
+    }
+
+    if err := dec.Decode(dst); err != nil {
+        if fieldErr, ok := decodeFieldError(err); ok {
+            return []error{fieldErr}, nil
+        }
+        return nil, decodeSyntaxError(err)
+    }
+    if dec.More() {
+        return nil, fmt.Errorf("invalid JSON at offset %d: unexpected data after the document", dec.InputOffset())
+    }
+
+    v := New()
+    if build != nil {
+        build(v, dst)
+    }
+    return v.Validate(false), nil
+}
+
+// decodeFieldError translates decoding errors caused by the content of
+// a single field into a ValidationError.
+func decodeFieldError(err error) (error, bool) {
+    var typeErr *json.UnmarshalTypeError
+    if errors.As(err, &typeErr) {
+        field := typeErr.Field
+        if field == "" {
+            field = "body"
+        }
+        return ValidationError{
+            Field:   field,
+            Rule:    "type",
+            Message: fmt.Sprintf("%s must be %s", field, jsonTypeName(typeErr.Type)),
+            Err:     err,
+        }, true
+    }
+
+    // The decoder reports unknown keys as `json: unknown field "name"`.
+    if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+        name = strings.Trim(name, `"`)
+        return ValidationError{
+            Field:   name,
+            Rule:    "unknown",
+            Message: fmt.Sprintf("%s is not a known field", name),
+            Err:     err,
+        }, true
+    }
+    return nil, false
+}
+
+// decodeSyntaxError describes a body that could not be decoded as JSON.
+func decodeSyntaxError(err error) error {
+    var syntaxErr *json.SyntaxError
+    switch {
+    case errors.As(err, &syntaxErr):
+        return fmt.Errorf("invalid JSON at offset %d: %w", syntaxErr.Offset, err)
+    case errors.Is(err, io.EOF):
+        return fmt.Errorf("invalid JSON: the body is empty: %w", err)
+    case errors.Is(err, io.ErrUnexpectedEOF):
+        return fmt.Errorf("invalid JSON: the body ended unexpectedly: %w", err)
+    }
+    return fmt.Errorf("invalid JSON: %w", err)
+}
+
+// jsonTypeName names the JSON type expected for a Go type.
+func jsonTypeName(t reflect.Type) string {
+    for t.Kind() == reflect.Ptr {
+        t = t.Elem()
+    }
+
+    switch {
+    case t.Kind() == reflect.String:
+        return "a string"
+    case t.Kind() == reflect.Bool:
+        return "a boolean"
+    case isNumberKind(t.Kind()):
+        return "a number"
+    case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+        return "an array"
+    }
+    return "an object"
+}
@@ -0,0 +1,176 @@
+package validator
+
+import (
+    "fmt"
+    "net/url"
+    "sort"
+    "strings"
+)
+
+// ParseBrackets turns form or query values sent with the bracket syntax of
+// frontend libraries into a document for ValidateMap or Schema.ValidateMap:
+//   - "tags[]=a&tags[]=b" becomes {"tags": []string{"a", "b"}}
+//   - "filter[status]=open" becomes {"filter": {"status": "open"}}
+//   - "a[b][c]=1" nests as deep as the brackets do
+//   - keys without brackets keep their first value, as in ValidateValues
+//
+// "[]" may only end a key. A malformed key, such as "filter[status" with an
+// unclosed bracket, or two keys that disagree, such as "filter=open" and
+// "filter[status]=open", are reported as an error naming the key.
+//
+// Example:
+//
+//    doc, err := validator.ParseBrackets(r.URL.Query())
+//    if err != nil {
+//        http.Error(w, err.Error(), http.StatusBadRequest)
+//        return
+//    }
+//    errs := validator.ValidateMap(doc, map[string]func(f *validator.Field){
+//        "filter.status": func(f *validator.Field) { f.Optional().OneOf([]interface{}{"open", "closed"}) },
+//        "tags":          func(f *validator.Field) { f.MinItems(1) },
+//    })
+func ParseBrackets(vals url.Values) (map[string]interface{}, error) {
+    keys := make([]string, 0, len(vals))
+    for key := range vals {
+        keys = append(keys, key)
+    }
+    sort.Strings(keys)
+
+    doc := map[string]interface{}{}
+    for _, key := range keys {
+        if err := insertBracketKey(doc, key, vals[key]); err != nil {
+            return nil, err
+        }
+    }
+    return doc, nil
+}
+
+// parseBracketKey splits a key such as "filter[status]" into its segments,
+// "filter" and "status". A key ending with "[]" has a last segment of "".
+func parseBracketKey(key string) ([]string, error) {
+    open := strings.IndexAny(key, "[]")
+    if open < 0 {
+        return []string{key}, nil
+    }
+    if open == 0 {
+        return nil, fmt.Errorf("query key %q must start with a name", key)
+    }
+
+    segments := []string{key[:open]}
+    rest := key[open:]
+    for rest != "" {
+        if rest[0] != '[' {
+            return nil, fmt.Errorf("query key %q has text or a ] outside of brackets", key)
+        }
+        end := strings.IndexAny(rest[1:], "[]")
+        if end < 0 || rest[1+end] != ']' {
+            return nil, fmt.Errorf("query key %q has an unclosed bracket", key)
+        }
+        segments = append(segments, rest[1:1+end])
+        rest = rest[2+end:]
+    }
+
+    for _, segment := range segments[1 : len(segments)-1] {
+        if segment == "" {
+            return nil, fmt.Errorf("query key %q can only have [] at its end", key)
+        }
+    }
+    return segments, nil
+}
+
+// insertBracketKey adds the values sent for `key` to doc.
+func insertBracketKey(doc map[string]interface{}, key string, values []string) error {
+    segments, err := parseBracketKey(key)
+    if err != nil {
+        return err
+    }
+
+    current := doc
+    for i, segment := range segments {
+        last := i == len(segments)-1
+        next := i == len(segments)-2 && segments[i+1] == ""
+        existing, found := current[segment]
+
+        switch {
+        case last:
+            if found {
+                return fmt.Errorf("query key %q conflicts with another key for %s", key, strings.Join(segments, "."))
+            }
+            if len(values) > 0 {
+                current[segment] = values[0]
+            } else {
+                current[segment] = ""
+            }
+            return nil
+        case next:
+            if found {
+                return fmt.Errorf("query key %q conflicts with another key for %s", key, strings.Join(segments[:i+1], "."))
+            }
+            current[segment] = append([]string(nil), values...)
+            return nil
+        }
+
+        if !found {
+            nested := map[string]interface{}{}
+            current[segment] = nested
+            current = nested
+            continue
+        }
+        nested, ok := existing.(map[string]interface{})
+        if !ok {
+            return fmt.Errorf("query key %q conflicts with another key for %s", key, strings.Join(segments[:i+1], "."))
+        }
+        current = nested
+    }
+    return nil
+}
+
+// bracketValue returns the value of the bracket key `segments` among vals,
+// parsing only the keys that share its first segment, and whether it was
+// sent.
+func bracketValue(vals url.Values, segments []string) (interface{}, bool, error) {
+    doc := map[string]interface{}{}
+    base := segments[0]
+    keys := make([]string, 0, len(vals))
+    for key := range vals {
+        if key == base || strings.HasPrefix(key, base+"[") || strings.HasPrefix(key, base+"]") {
+            keys = append(keys, key)
+        }
+    }
+    sort.Strings(keys)
+    for _, key := range keys {
+        if err := insertBracketKey(doc, key, vals[key]); err != nil {
+            return nil, false, err
+        }
+    }
+
+    var current interface{} = doc
+    for _, segment := range segments {
+        if segment == "" {
+            break
+        }
+        object, ok := current.(map[string]interface{})
+        if !ok {
+            return nil, false, fmt.Errorf("%s is not an object in the query", strings.Join(segments, "."))
+        }
+        if current, ok = object[segment]; !ok {
+            return nil, false, nil
+        }
+    }
+
+    if segments[len(segments)-1] == "" {
+        if _, ok := current.([]string); !ok {
+            return nil, false, fmt.Errorf("%s is not a list in the query", strings.Join(segments[:len(segments)-1], "."))
+        }
+    }
+    return current, true, nil
+}
+
+// bracketPath returns the dotted path of bracket key segments, such as
+// "filter.status" for "filter[status]" and "tags" for "tags[]".
+func bracketPath(segments []string) string {
+    if segments[len(segments)-1] == "" {
+        segments = segments[:len(segments)-1]
+    }
+    return strings.Join(segments, ".")
+}
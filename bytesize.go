@@ -0,0 +1,142 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// byteSizePattern matches a non-negative number, an optional space and
+// an optional unit.
+var byteSizePattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?) ?([A-Za-z]*)$`)
+
+// byteSizeUnits maps the lower-cased units accepted by ByteSize to their
+// size in bytes.
+var byteSizeUnits = map[string]int64{
+    "":    1,
+    "b":   1,
+    "kb":  1e3,
+    "mb":  1e6,
+    "gb":  1e9,
+    "tb":  1e12,
+    "pb":  1e15,
+    "kib": 1 << 10,
+    "mib": 1 << 20,
+    "gib": 1 << 30,
+    "tib": 1 << 40,
+    "pib": 1 << 50,
+}
+
+// ByteSize validates that the value is a size in bytes written for humans,
+// such as "512", "10KB", "1.5MiB" or "2 gb", and converts it to an int64
+// number of bytes so that ByteSizeBetween, Min and Max can follow and
+// Value returns it after Validate. KB, MB, GB, TB and PB are decimal
+// (1000) and KiB, MiB, GiB, TiB and PiB binary (1024) units, in any case.
+// Negative sizes, fractions of a byte and unknown units such as "10MBps"
+// fail.
+// Accepts an optional custom error message.
+//
+// Example:
+//    quota := v.Field(form.Quota, "Quota").ByteSize().ByteSizeBetween(1<<20, 10e9)
+//    // after Validate, quota.Value() is an int64
+func (f *Field) ByteSize(messages ...string) *Field {
+    f.addRule("byteSize", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var size int64
+        if ok {
+            size, ok = parseByteSize(str)
+        }
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a size such as 512KB or 1.5GiB", f.name)
+        }
+
+        f.value = size
+        return nil
+    })
+    return f
+}
+
+// ByteSizeBetween validates that a size is between min and max bytes,
+// inclusive. The value may be a string accepted by ByteSize or a number
+// of bytes, such as the result of ByteSize.
+// A min above max is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ByteSizeBetween(1<<20, 10<<30) // 1MiB to 10GiB
+func (f *Field) ByteSizeBetween(min, max int64, messages ...string) *Field {
+    f.addRule("byteSizeBetween", []interface{}{min, max}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        var size int64
+        ok := false
+        if str, isString := f.value.(string); isString {
+            size, ok = parseByteSize(str)
+        } else if isInteger(f.value) {
+            n, _ := toFloat64(f.value)
+            size, ok = int64(n), true
+        }
+
+        if !ok || size < min || size > max {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be between %s and %s", f.name, formatByteSize(min), formatByteSize(max))
+        }
+        return nil
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// parseByteSize parses a size written as accepted by ByteSize into bytes.
+func parseByteSize(str string) (int64, bool) {
+    match := byteSizePattern.FindStringSubmatch(str)
+    if match == nil {
+        return 0, false
+    }
+    unit, ok := byteSizeUnits[strings.ToLower(match[2])]
+    if !ok {
+        return 0, false
+    }
+
+    if !strings.Contains(match[1], ".") {
+        n, err := strconv.ParseInt(match[1], 10, 64)
+        if err != nil || n > math.MaxInt64/unit {
+            return 0, false
+        }
+        return n * unit, true
+    }
+
+    n, err := strconv.ParseFloat(match[1], 64)
+    size := n * float64(unit)
+    if err != nil || size >= math.MaxInt64 || size != math.Trunc(size) {
+        return 0, false
+    }
+    return int64(size), true
+}
+
+// formatByteSize writes a size in bytes with the largest unit that divides
+// it exactly, such as "10GB" or "512KiB", for error messages.
+func formatByteSize(size int64) string {
+    best, bestUnit := "B", int64(1)
+    for _, unit := range []string{"KB", "MB", "GB", "TB", "PB", "KiB", "MiB", "GiB", "TiB", "PiB"} {
+        bytes := byteSizeUnits[strings.ToLower(unit)]
+        if size != 0 && size%bytes == 0 && bytes > bestUnit {
+            best, bestUnit = unit, bytes
+        }
+    }
+    return strconv.FormatInt(size/bestUnit, 10) + best
+}
@@ -0,0 +1,145 @@
+package validator
+
+import (
+    "container/list"
+    "reflect"
+    "sync"
+    "time"
+)
+
+// RuleCache memoizes the outcome of an expensive rule function, such as an
+// MX lookup or a uniqueness check against a remote service, so validating a
+// batch with repeated values calls it once per distinct value. Create one
+// with Cached and share it between validators; it is safe for concurrent
+// use.
+type RuleCache struct {
+    rule       func(value interface{}) error
+    ttl        time.Duration
+    maxEntries int
+
+    mu      sync.Mutex
+    bypass  bool
+    entries map[interface{}]*list.Element
+    recent  *list.List // of *cacheEntry, most recently used first
+}
+
+// cacheEntry is the outcome of the rule for one value.
+type cacheEntry struct {
+    value   interface{}
+    err     error
+    expires time.Time
+}
+
+// Cached wraps `rule` in a RuleCache whose Check method can be passed to
+// Custom. Outcomes, both nil and errors, are cached by value for `ttl`, and
+// at most `maxEntries` are kept, dropping the least recently used first. A
+// ttl or maxEntries of 0 or less means no expiry or no limit.
+//
+// Only comparable values, such as strings, numbers and structs of them, are
+// cached; for others, such as slices and maps, the rule is called every
+// time. Concurrent checks of a value that isn't cached yet may each call
+// the rule. A panic inside the rule is not cached.
+//
+// Example:
+//
+//    mx := validator.Cached(func(value interface{}) error {
+//        return checkMX(value.(string))
+//    }, 10*time.Minute, 10000)
+//
+//    for _, row := range rows {
+//        v := validator.New()
+//        v.Field(row.Email, "Email").Required().Email().Custom(mx.Check)
+//        ...
+//    }
+func Cached(rule func(value interface{}) error, ttl time.Duration, maxEntries int) *RuleCache {
+    return &RuleCache{
+        rule:       rule,
+        ttl:        ttl,
+        maxEntries: maxEntries,
+        entries:    map[interface{}]*list.Element{},
+        recent:     list.New(),
+    }
+}
+
+// Check returns the outcome of the rule for value, from the cache when it
+// holds an unexpired one.
+func (c *RuleCache) Check(value interface{}) error {
+    if value != nil && !reflect.ValueOf(value).Comparable() {
+        return c.rule(value)
+    }
+
+    c.mu.Lock()
+    if c.bypass {
+        c.mu.Unlock()
+        return c.rule(value)
+    }
+    if elem, ok := c.entries[value]; ok {
+        entry := elem.Value.(*cacheEntry)
+        if c.ttl <= 0 || time.Now().Before(entry.expires) {
+            c.recent.MoveToFront(elem)
+            c.mu.Unlock()
+            return entry.err
+        }
+        c.remove(elem)
+    }
+    c.mu.Unlock()
+
+    err := c.rule(value)
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    if elem, ok := c.entries[value]; ok {
+        c.remove(elem)
+    }
+    entry := &cacheEntry{value: value, err: err, expires: time.Now().Add(c.ttl)}
+    c.entries[value] = c.recent.PushFront(entry)
+    for c.maxEntries > 0 && c.recent.Len() > c.maxEntries {
+        c.remove(c.recent.Back())
+    }
+    return err
+}
+
+// Bypass controls whether Check skips the cache and calls the rule every
+// time, leaving the cached outcomes untouched, such as in tests that
+// change what the rule returns.
+func (c *RuleCache) Bypass(enabled bool) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.bypass = enabled
+}
+
+// Forget removes the cached outcome for value, such as after the email it
+// checked was registered.
+func (c *RuleCache) Forget(value interface{}) {
+    if value != nil && !reflect.ValueOf(value).Comparable() {
+        return
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    if elem, ok := c.entries[value]; ok {
+        c.remove(elem)
+    }
+}
+
+// Purge removes every cached outcome.
+func (c *RuleCache) Purge() {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.entries = map[interface{}]*list.Element{}
+    c.recent.Init()
+}
+
+// Len returns the number of cached outcomes, including expired ones that
+// were not evicted yet.
+func (c *RuleCache) Len() int {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.recent.Len()
+}
+
+// remove drops a cached outcome. The caller must hold c.mu.
+func (c *RuleCache) remove(elem *list.Element) {
+    delete(c.entries, elem.Value.(*cacheEntry).value)
+    c.recent.Remove(elem)
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+    "errors"
+    "fmt"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// emailRows returns n emails with `distinct` different values.
+func emailRows(n, distinct int) []string {
+    rows := make([]string, n)
+    for i := range rows {
+        rows[i] = fmt.Sprintf("user%d@example.com", i%distinct)
+    }
+    return rows
+}
+
+// validateEmails validates every email with check, as an import would.
+func validateEmails(rows []string, check func(value interface{}) error) {
+    for _, email := range rows {
+        v := New()
+        v.Field(email, "Email").Required().Email().Custom(check)
+        v.Validate(false)
+    }
+}
+
+func TestCachedLookups(t *testing.T) {
+    var lookups atomic.Int64
+    mx := Cached(func(value interface{}) error {
+        lookups.Add(1)
+        return nil
+    }, time.Minute, 1000)
+
+    validateEmails(emailRows(10000, 100), mx.Check)
+    if n := lookups.Load(); n != 100 {
+        t.Errorf("10000 rows with 100 distinct emails made %d lookups, want 100", n)
+    }
+}
+
+func TestCachedPurgeAndForget(t *testing.T) {
+    var lookups atomic.Int64
+    taken := errors.New("is taken")
+    unique := Cached(func(value interface{}) error {
+        lookups.Add(1)
+        return taken
+    }, 0, 0)
+
+    unique.Check("ada")
+    unique.Check("bob")
+    unique.Forget("ada")
+    if unique.Len() != 1 {
+        t.Errorf("Len() = %d after Forget, want 1", unique.Len())
+    }
+    unique.Check("ada")
+    unique.Check("bob")
+    if n := lookups.Load(); n != 3 {
+        t.Errorf("made %d lookups, want 3: ada twice, bob once", n)
+    }
+
+    unique.Purge()
+    if unique.Len() != 0 {
+        t.Errorf("Len() = %d after Purge, want 0", unique.Len())
+    }
+    if err := unique.Check("bob"); err != taken || lookups.Load() != 4 {
+        t.Errorf("Check after Purge returned %v after %d lookups", err, lookups.Load())
+    }
+}
+
+func TestCachedLimits(t *testing.T) {
+    var lookups atomic.Int64
+    rule := Cached(func(value interface{}) error {
+        lookups.Add(1)
+        return nil
+    }, 20*time.Millisecond, 2)
+
+    rule.Check("a")
+    rule.Check("b")
+    rule.Check("c") // evicts a
+    if rule.Len() != 2 {
+        t.Errorf("Len() = %d, want the limit of 2", rule.Len())
+    }
+    rule.Check("a")
+    if n := lookups.Load(); n != 4 {
+        t.Errorf("made %d lookups, want 4 as a was evicted", n)
+    }
+
+    time.Sleep(30 * time.Millisecond)
+    rule.Check("a")
+    if n := lookups.Load(); n != 5 {
+        t.Errorf("made %d lookups, want 5 as a expired", n)
+    }
+
+    rule.Bypass(true)
+    rule.Check("c")
+    rule.Check([]string{"not comparable"})
+    if n := lookups.Load(); n != 7 {
+        t.Errorf("made %d lookups, want 7 with the cache bypassed", n)
+    }
+}
+
+func BenchmarkCachedRule(b *testing.B) {
+    rows := emailRows(10000, 100)
+
+    b.Run("uncached", func(b *testing.B) {
+        var lookups atomic.Int64
+        lookup := func(value interface{}) error {
+            lookups.Add(1)
+            return nil
+        }
+        b.ReportAllocs()
+        for b.Loop() {
+            validateEmails(rows, lookup)
+        }
+        b.ReportMetric(float64(lookups.Load())/float64(b.N), "lookups/op")
+    })
+    b.Run("cached", func(b *testing.B) {
+        var lookups atomic.Int64
+        lookup := func(value interface{}) error {
+            lookups.Add(1)
+            return nil
+        }
+        b.ReportAllocs()
+        for b.Loop() {
+            // A new cache per run, so each run makes its own lookups.
+            validateEmails(rows, Cached(lookup, time.Minute, 1000).Check)
+        }
+        b.ReportMetric(float64(lookups.Load())/float64(b.N), "lookups/op")
+    })
+}
@@ -0,0 +1,139 @@
+package validator
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// CalendarOption relaxes the checks of WeekdayWith and MonthWith.
+// Options can be combined with |.
+type CalendarOption int
+
+const (
+    // CalendarAllowNumbers also accepts numbers, as integers or strings:
+    // 1 to 7 for Monday to Sunday (ISO 8601), or 1 to 12 for the months.
+    CalendarAllowNumbers CalendarOption = 1 << iota
+)
+
+// Weekday validates that the value is an English weekday name, in full or
+// abbreviated to three letters, ignoring case, such as "monday" or "MON",
+// and converts the value to a time.Weekday for the rules after it and for
+// Value. Other abbreviations, such as "S" or "Tues", are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Weekday()
+//    f.Weekday("Day must be a day of the week")
+func (f *Field) Weekday(messages ...string) *Field {
+    return f.WeekdayWith(0, messages...)
+}
+
+// WeekdayWith is Weekday with options, such as CalendarAllowNumbers to
+// also accept 1 (Monday) to 7 (Sunday).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.WeekdayWith(validator.CalendarAllowNumbers) // "mon", "1" and 1 become time.Monday
+func (f *Field) WeekdayWith(opts CalendarOption, messages ...string) *Field {
+    var params []interface{}
+    if opts != 0 {
+        params = []interface{}{opts}
+    }
+
+    f.addRule("weekday", params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, ok := calendarIndex(f.value, opts, 7, func(i int) string {
+            return time.Weekday((i + 1) % 7).String()
+        })
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a day of the week", f.name)
+        }
+
+        f.value = time.Weekday(n % 7)
+        return nil
+    })
+    return f
+}
+
+// Month validates that the value is an English month name, in full or
+// abbreviated to three letters, ignoring case, such as "january" or
+// "JAN", and converts the value to a time.Month for the rules after it and
+// for Value. Other abbreviations, such as "Sept", are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Month()
+func (f *Field) Month(messages ...string) *Field {
+    return f.MonthWith(0, messages...)
+}
+
+// MonthWith is Month with options, such as CalendarAllowNumbers to also
+// accept 1 to 12.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MonthWith(validator.CalendarAllowNumbers) // "feb", "2" and 2 become time.February
+func (f *Field) MonthWith(opts CalendarOption, messages ...string) *Field {
+    var params []interface{}
+    if opts != 0 {
+        params = []interface{}{opts}
+    }
+
+    f.addRule("month", params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, ok := calendarIndex(f.value, opts, 12, func(i int) string {
+            return time.Month(i + 1).String()
+        })
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a month", f.name)
+        }
+
+        f.value = time.Month(n)
+        return nil
+    })
+    return f
+}
+
+// calendarIndex returns the 1-based position of value among `count` names,
+// where name(i) is the English name at 0-based position i. Full names and
+// their first three letters match, ignoring case, and so do the numbers 1
+// to count when CalendarAllowNumbers is set.
+func calendarIndex(value interface{}, opts CalendarOption, count int, name func(i int) string) (int, bool) {
+    str, isString := value.(string)
+    if opts&CalendarAllowNumbers != 0 {
+        if isInteger(value) {
+            n, _ := toFloat64(value)
+            return int(n), n >= 1 && n <= float64(count)
+        }
+        if n, err := strconv.Atoi(str); isString && err == nil {
+            return n, n >= 1 && n <= count
+        }
+    }
+    if !isString {
+        return 0, false
+    }
+
+    for i := 0; i < count; i++ {
+        full := name(i)
+        if strings.EqualFold(str, full) || strings.EqualFold(str, full[:3]) {
+            return i + 1, true
+        }
+    }
+    return 0, false
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+)
+
+// identifierCase describes an identifier style accepted by IdentifierCase.
+type identifierCase struct {
+    pattern *regexp.Regexp
+    example string
+}
+
+// identifierCases maps the styles accepted by IdentifierCase to their
+// definitions. All of them are ASCII only and start with a letter.
+var identifierCases = map[string]identifierCase{
+    "snake_case": {regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`), "user_name"},
+    "kebab-case": {regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`), "user-name"},
+    "camelCase":  {regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`), "userName"},
+    "PascalCase": {regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`), "UserName"},
+}
+
+// IdentifierCase validates that the value is an identifier written in
+// `style`, one of "snake_case", "kebab-case", "camelCase" or "PascalCase".
+// See SnakeCase, KebabCase, CamelCase and PascalCase for the exact
+// definitions. An unknown style is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IdentifierCase("snake_case")
+//    f.IdentifierCase(settings.KeyStyle, "Key has the wrong format")
+func (f *Field) IdentifierCase(style string, messages ...string) *Field {
+    c, known := identifierCases[style]
+    var styleErr error
+    if !known {
+        styleErr = fmt.Errorf("unknown identifier case %q", style)
+    }
+
+    f.addRule("identifierCase", []interface{}{style}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !c.pattern.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be in %s, such as %s", f.name, style, c.example)
+        }
+        return nil
+    })
+    return f.misconfigured(styleErr)
+}
+
+// SnakeCase validates that the value is in snake_case: lowercase ASCII
+// letters and digits in words joined by single underscores, starting with
+// a letter. Leading, trailing and consecutive underscores are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.SnakeCase() // "user_name", "address2"; not "user__name", "_user" or "userName"
+func (f *Field) SnakeCase(messages ...string) *Field {
+    return f.IdentifierCase("snake_case", messages...)
+}
+
+// KebabCase validates that the value is in kebab-case: lowercase ASCII
+// letters and digits in words joined by single hyphens, starting with a
+// letter. Leading, trailing and consecutive hyphens are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.KebabCase() // "order-created"; not "order--created", "-order" or "order_created"
+func (f *Field) KebabCase(messages ...string) *Field {
+    return f.IdentifierCase("kebab-case", messages...)
+}
+
+// CamelCase validates that the value is in camelCase: ASCII letters and
+// digits only, starting with a lowercase letter. Runs of capitals such as
+// "userID" are allowed, and a single lowercase word such as "user" is
+// valid camelCase. Separators are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.CamelCase() // "orderCreated", "userID"; not "OrderCreated" or "order_created"
+func (f *Field) CamelCase(messages ...string) *Field {
+    return f.IdentifierCase("camelCase", messages...)
+}
+
+// PascalCase validates that the value is in PascalCase: ASCII letters and
+// digits only, starting with an uppercase letter. Separators are rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PascalCase() // "UserName", "HTTPServer"; not "userName" or "User_Name"
+func (f *Field) PascalCase(messages ...string) *Field {
+    return f.IdentifierCase("PascalCase", messages...)
+}
@@ -0,0 +1,66 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestIdentifierCase(t *testing.T) {
+    tests := []struct {
+        value                       string
+        snake, kebab, camel, pascal bool
+    }{
+        {"user", true, true, true, false},
+        {"user_name", true, false, false, false},
+        {"address2", true, true, true, false},
+        {"user__name", false, false, false, false},
+        {"_user", false, false, false, false},
+        {"user_", false, false, false, false},
+        {"2user", false, false, false, false},
+        {"user-name", false, true, false, false},
+        {"user--name", false, false, false, false},
+        {"-user", false, false, false, false},
+        {"userName", false, false, true, false},
+        {"userID", false, false, true, false},
+        {"UserName", false, false, false, true},
+        {"HTTPServer", false, false, false, true},
+        {"User_Name", false, false, false, false},
+        {"usér", false, false, false, false},
+        {"", false, false, false, false},
+    }
+
+    for _, tt := range tests {
+        for style, want := range map[string]bool{"snake_case": tt.snake, "kebab-case": tt.kebab, "camelCase": tt.camel, "PascalCase": tt.pascal} {
+            v := New()
+            v.Field(tt.value, "Key").IdentifierCase(style)
+            if valid := v.Validate(false) == nil; valid != want {
+                t.Errorf("%s(%q): valid = %v, want %v", style, tt.value, valid, want)
+            }
+        }
+    }
+}
+
+func TestIdentifierCaseMessages(t *testing.T) {
+    tests := []struct {
+        rule    func(f *Field)
+        message string
+    }{
+        {func(f *Field) { f.SnakeCase() }, "Key must be in snake_case, such as user_name"},
+        {func(f *Field) { f.KebabCase() }, "Key must be in kebab-case, such as user-name"},
+        {func(f *Field) { f.CamelCase() }, "Key must be in camelCase, such as userName"},
+        {func(f *Field) { f.PascalCase("Key has the wrong format") }, "Key has the wrong format"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        tt.rule(v.Field("User Name", "Key"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("got %q, want %q", got, tt.message)
+        }
+    }
+
+    v := New()
+    v.Field("user", "Key").IdentifierCase("SCREAMING_CASE")
+    if v.Err() == nil {
+        t.Error("an unknown style is not a configuration error")
+    }
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+    "fmt"
+    "unicode"
+)
+
+// charRange is a range of characters, including both ends.
+type charRange struct {
+    lo, hi rune
+}
+
+// parseCharset parses a character set such as "A-Z0-9-": a "-" between
+// two characters is a range, including both, and any other character,
+// including a "-" at the start or end, stands for itself.
+func parseCharset(set string) ([]charRange, error) {
+    runes := []rune(set)
+    var ranges []charRange
+    for i := 0; i < len(runes); i++ {
+        lo, hi := runes[i], runes[i]
+        if i+2 < len(runes) && runes[i+1] == '-' {
+            hi = runes[i+2]
+            i += 2
+        }
+        if hi < lo {
+            return nil, fmt.Errorf("invalid range %c-%c", lo, hi)
+        }
+        ranges = append(ranges, charRange{lo, hi})
+    }
+    return ranges, nil
+}
+
+// inCharset reports whether r is in one of `ranges`.
+func inCharset(ranges []charRange, r rune) bool {
+    for _, rng := range ranges {
+        if r >= rng.lo && r <= rng.hi {
+            return true
+        }
+    }
+    return false
+}
+
+// Charset validates that a string only contains the characters of
+// `allowed`, written as a set with ranges, such as "A-Z0-9-" for coupon
+// codes or "0-9a-f" for hex tokens: a "-" between two characters is a
+// range, and any other character, including a "-" at the start or end,
+// stands for itself. The error names the first disallowed character and
+// its position, counted in characters from 1. An invalid range, such as
+// "z-a", is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Charset("A-Z0-9-") // "SAVE-2024"; not "save 2024"
+//    // Code contains a disallowed character ' ' at position 5
+func (f *Field) Charset(allowed string, messages ...string) *Field {
+    ranges, err := parseCharset(allowed)
+    contains := func(r rune) bool { return inCharset(ranges, r) }
+    return f.checkCharset("charset", []interface{}{allowed}, contains, true, messages).misconfigured(err)
+}
+
+// CharsetIn is Charset for character classes given as Unicode range
+// tables, such as unicode.Letter or unicode.Han; a character is allowed
+// when it is in any of `tables`.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.CharsetIn([]*unicode.RangeTable{unicode.Letter, unicode.Digit, unicode.Space})
+func (f *Field) CharsetIn(tables []*unicode.RangeTable, messages ...string) *Field {
+    contains := func(r rune) bool { return unicode.In(r, tables...) }
+    return f.checkCharset("charsetIn", nil, contains, true, messages)
+}
+
+// ExcludeChars validates that a string contains none of the characters of
+// `disallowed`, written as for Charset, such as `"'\` for quotes and
+// backslashes. The error names the first disallowed character and its
+// position. An invalid range is a *ConfigError.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ExcludeChars(`"'\`)
+func (f *Field) ExcludeChars(disallowed string, messages ...string) *Field {
+    ranges, err := parseCharset(disallowed)
+    contains := func(r rune) bool { return inCharset(ranges, r) }
+    return f.checkCharset("excludeChars", []interface{}{disallowed}, contains, false, messages).misconfigured(err)
+}
+
+// checkCharset adds a rule named `name` checking that `contains` reports
+// every character of the value when `allow` is set, or none otherwise.
+func (f *Field) checkCharset(name string, params []interface{}, contains func(r rune) bool, allow bool, messages []string) *Field {
+    f.addRule(name, params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a string")
+        }
+
+        position := 0
+        for _, r := range str {
+            position++
+            if contains(r) == allow {
+                continue
+            }
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s contains a disallowed character %q at position %d", f.name, r, position)
+        }
+        return nil
+    })
+    return f
+}
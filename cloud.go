@@ -0,0 +1,110 @@
+package validator
+
+import (
+    "fmt"
+    "net"
+    "regexp"
+    "strings"
+)
+
+var (
+    arnPartitionPattern = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+    arnServicePattern   = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+    arnRegionPattern    = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+    arnAccountPattern   = regexp.MustCompile(`^([0-9]{12}|aws)$`)
+    s3BucketPattern     = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+)
+
+// ARN validates that the value is an AWS Amazon Resource Name of the form
+// "arn:partition:service:region:account-id:resource". The region and
+// account may be empty, as they are for global services such as IAM and
+// S3, and the resource may itself contain ":" and "/".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ARN() // "arn:aws:iam::123456789012:role/deploy", "arn:aws:s3:::my-bucket/logs/*"
+//    f.ARN("Role must be an ARN")
+func (f *Field) ARN(messages ...string) *Field {
+    f.addRule("arn", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isARN(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid ARN", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isARN reports whether str is a well-formed ARN.
+func isARN(str string) bool {
+    parts := strings.SplitN(str, ":", 6)
+    if len(parts) != 6 || parts[0] != "arn" {
+        return false
+    }
+
+    partition, service, region, account, resource := parts[1], parts[2], parts[3], parts[4], parts[5]
+    return arnPartitionPattern.MatchString(partition) &&
+        arnServicePattern.MatchString(service) &&
+        (region == "" || arnRegionPattern.MatchString(region)) &&
+        (account == "" || arnAccountPattern.MatchString(account)) &&
+        resource != ""
+}
+
+// S3BucketName validates that the value follows the S3 bucket naming
+// rules: 3–63 characters of lowercase letters, digits, dots and hyphens,
+// starting and ending with a letter or digit, without two adjacent dots,
+// not formatted as an IP address, and without the prefixes and suffixes
+// S3 reserves ("xn--", "sthree-", "-s3alias", "--ol-s3").
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.S3BucketName()
+//    f.S3BucketName("Bucket name is not valid")
+func (f *Field) S3BucketName(messages ...string) *Field {
+    f.addRule("s3BucketName", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isS3BucketName(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid S3 bucket name", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isS3BucketName reports whether str is a valid S3 bucket name.
+func isS3BucketName(str string) bool {
+    if !s3BucketPattern.MatchString(str) || strings.Contains(str, "..") {
+        return false
+    }
+    if net.ParseIP(str) != nil {
+        return false
+    }
+
+    for _, prefix := range []string{"xn--", "sthree-"} {
+        if strings.HasPrefix(str, prefix) {
+            return false
+        }
+    }
+    for _, suffix := range []string{"-s3alias", "--ol-s3"} {
+        if strings.HasSuffix(str, suffix) {
+            return false
+        }
+    }
+    return true
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestARN(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"arn:aws:iam::123456789012:role/deploy", true},
+        {"arn:aws:iam::aws:policy/AdministratorAccess", true},
+        {"arn:aws:s3:::my-bucket", true},
+        {"arn:aws:s3:::my-bucket/logs/2024/*", true},
+        {"arn:aws:sns:us-east-1:123456789012:alerts", true},
+        {"arn:aws:lambda:eu-west-1:123456789012:function:resize:prod", true},
+        {"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-0abc", true},
+        {"arn:aws-us-gov:s3:::gov-bucket", true},
+
+        {"", false},
+        {"arn:aws:iam::123456789012", false},
+        {"arn:aws:iam::123456789012:", false},
+        {"arn:azure:iam::123456789012:role/deploy", false},
+        {"arn:aws:IAM::123456789012:role/deploy", false},
+        {"arn:aws:sns:useast1:123456789012:alerts", false},
+        {"arn:aws:sns:us-east-1:12345:alerts", false},
+        {"urn:aws:s3:::my-bucket", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Role").ARN()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("ARN(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestS3BucketName(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"my-bucket", true},
+        {"logs.example.com", true},
+        {"abc", true},
+        {"a1b", true},
+        {strings.Repeat("a", 63), true},
+        {"192.168.5.4.backup", true},
+
+        {"ab", false},
+        {strings.Repeat("a", 64), false},
+        {"192.168.5.4", false},
+        {"10.0.0.1", false},
+        {"my..bucket", false},
+        {"My-Bucket", false},
+        {"my_bucket", false},
+        {"-bucket", false},
+        {"bucket-", false},
+        {".bucket", false},
+        {"xn--bucket", false},
+        {"sthree-bucket", false},
+        {"bucket-s3alias", false},
+        {"bucket--ol-s3", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Bucket").S3BucketName()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("S3BucketName(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
@@ -0,0 +1,194 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MinItems validates that a slice, array, or map holds at least `count` items.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinItems(1)
+//    f.MinItems(1, "Pick at least one tag")
+func (f *Field) MinItems(count int, messages ...string) *Field {
+    f.addRule("minItems", []interface{}{count}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, ok := itemCount(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a list", f.name)
+        }
+
+        if n < count {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must contain at least %d items", f.name, count)
+        }
+        return nil
+    })
+    return f
+}
+
+// MaxItems validates that a slice, array, or map holds no more than `count` items.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxItems(5)
+//    f.MaxItems(5, "Too many tags")
+func (f *Field) MaxItems(count int, messages ...string) *Field {
+    f.addRule("maxItems", []interface{}{count}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, ok := itemCount(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a list", f.name)
+        }
+
+        if n > count {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain more than %d items", f.name, count)
+        }
+        return nil
+    })
+    return f
+}
+
+// itemCount returns the length of a slice, array, or map value.
+// A nil value counts as an empty list.
+func itemCount(value interface{}) (int, bool) {
+    if value == nil {
+        return 0, true
+    }
+
+    rv := reflect.ValueOf(value)
+    switch rv.Kind() {
+    case reflect.Slice, reflect.Array, reflect.Map:
+        return rv.Len(), true
+    }
+    return 0, false
+}
+
+// Subset validates that every item of a list is one of `allowed`, such as
+// permissions picked from a fixed set. The error names the first item that
+// is not allowed and its index. Items may be strings or have a string
+// underlying type, so []Permission works as well as []string.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Subset([]string{"read", "write", "admin"})
+//    f.Subset(allowedScopes, "Unknown scope requested")
+func (f *Field) Subset(allowed []string, messages ...string) *Field {
+    f.addRule("subset", []interface{}{allowed}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        items, ok := stringItems(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a list of strings", f.name)
+        }
+
+        for i, item := range items {
+            if !slices.Contains(allowed, item) {
+                if message != "" {
+                    return fmt.Errorf("%s", message)
+                }
+                return fmt.Errorf("%s contains %q at index %d, which is not allowed", f.name, item, i)
+            }
+        }
+        return nil
+    })
+    return f
+}
+
+// ContainsAll validates that a list includes every item of `required`.
+// The error lists the missing items. Items may be strings or have a string
+// underlying type.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ContainsAll([]string{"read"})
+//    f.ContainsAll([]string{"openid", "profile"}, "Scopes openid and profile are required")
+func (f *Field) ContainsAll(required []string, messages ...string) *Field {
+    f.addRule("containsAll", []interface{}{required}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        items, ok := stringItems(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a list of strings", f.name)
+        }
+
+        var missing []string
+        for _, want := range required {
+            quoted := strconv.Quote(want)
+            if !slices.Contains(items, want) && !slices.Contains(missing, quoted) {
+                missing = append(missing, quoted)
+            }
+        }
+        if len(missing) > 0 {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must include %s", f.name, strings.Join(missing, ", "))
+        }
+        return nil
+    })
+    return f
+}
+
+// stringItems returns the items of a slice or array whose elements are
+// strings, including []interface{} holding strings as decoded from JSON.
+// A nil value is an empty list.
+func stringItems(value interface{}) ([]string, bool) {
+    if value == nil {
+        return nil, true
+    }
+
+    rv := reflect.ValueOf(value)
+    if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+        return nil, false
+    }
+
+    items := make([]string, rv.Len())
+    for i := range items {
+        item := rv.Index(i)
+        if item.Kind() == reflect.Interface {
+            item = item.Elem()
+        }
+        if item.Kind() != reflect.String {
+            return nil, false
+        }
+        items[i] = item.String()
+    }
+    return items, true
+}
+
@@ -0,0 +1,212 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ColorFormat selects the color notations accepted by ColorIn.
+// Formats can be combined with |.
+type ColorFormat int
+
+const (
+    // ColorFormatHex accepts #rgb, #rgba, #rrggbb and #rrggbbaa.
+    ColorFormatHex ColorFormat = 1 << iota
+    // ColorFormatRGB accepts rgb() and rgba().
+    ColorFormatRGB
+    // ColorFormatHSL accepts hsl() and hsla().
+    ColorFormatHSL
+    // ColorFormatNamed accepts CSS named colors such as "rebeccapurple".
+    ColorFormatNamed
+
+    // ColorFormatAll accepts every supported notation.
+    ColorFormatAll = ColorFormatHex | ColorFormatRGB | ColorFormatHSL | ColorFormatNamed
+)
+
+// Color validates that the value is a CSS color in any supported notation:
+// hex ("#ff0000"), rgb()/rgba(), hsl()/hsla(), or a named color.
+// Components are range checked, so "rgb(300, 0, 0)" is rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Color()
+//    f.Color("Pick a valid color")
+func (f *Field) Color(messages ...string) *Field {
+    return f.ColorIn(ColorFormatAll, messages...)
+}
+
+// ColorIn validates that the value is a CSS color written in one of
+// `formats`. In rgb() the red, green and blue components must be 0–255 or
+// 0%–100%; in hsl() saturation and lightness must be percentages; alpha
+// must be 0–1 or a percentage. Both the comma and the space separated
+// syntax ("rgb(255 0 0 / 0.5)") are accepted. Formats without any of the
+// ColorFormat flags are a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ColorIn(validator.ColorFormatHex | validator.ColorFormatRGB)
+func (f *Field) ColorIn(formats ColorFormat, messages ...string) *Field {
+    f.addRule("color", []interface{}{formats}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isColor(strings.TrimSpace(str), formats) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid color", f.name)
+        }
+        return nil
+    })
+    var err error
+    if formats&ColorFormatAll == 0 {
+        err = fmt.Errorf("no color format is accepted")
+    }
+    return f.misconfigured(err)
+}
+
+// hexColorPattern matches #rgb, #rgba, #rrggbb and #rrggbbaa.
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// colorFunctionPattern splits "name(arguments)".
+var colorFunctionPattern = regexp.MustCompile(`^(?i)(rgba?|hsla?)\((.*)\)$`)
+
+// isColor reports whether str is a color in one of the formats.
+func isColor(str string, formats ColorFormat) bool {
+    if strings.HasPrefix(str, "#") {
+        return formats&ColorFormatHex != 0 && hexColorPattern.MatchString(str)
+    }
+
+    if match := colorFunctionPattern.FindStringSubmatch(str); match != nil {
+        name := strings.ToLower(match[1])
+        args, alpha, ok := colorArguments(match[2])
+        if !ok || (alpha != "" && !isColorAlpha(alpha)) {
+            return false
+        }
+
+        if strings.HasPrefix(name, "rgb") {
+            if formats&ColorFormatRGB == 0 {
+                return false
+            }
+            for _, arg := range args {
+                if !isRGBComponent(arg) {
+                    return false
+                }
+            }
+            return true
+        }
+
+        if formats&ColorFormatHSL == 0 {
+            return false
+        }
+        return isHue(args[0]) && isPercentage(args[1]) && isPercentage(args[2])
+    }
+
+    return formats&ColorFormatNamed != 0 && cssNamedColors[strings.ToLower(str)]
+}
+
+// colorArguments splits the arguments of a color function into the three
+// color components and the optional alpha, for both "r, g, b, a" and
+// "r g b / a".
+func colorArguments(inner string) ([]string, string, bool) {
+    var parts []string
+    alpha := ""
+
+    if strings.Contains(inner, ",") {
+        for _, part := range strings.Split(inner, ",") {
+            parts = append(parts, strings.TrimSpace(part))
+        }
+        if len(parts) == 4 {
+            alpha = parts[3]
+            parts = parts[:3]
+        }
+    } else {
+        components, rest, found := strings.Cut(inner, "/")
+        parts = strings.Fields(components)
+        if found {
+            alpha = strings.TrimSpace(rest)
+            if alpha == "" {
+                return nil, "", false
+            }
+        }
+    }
+
+    if len(parts) != 3 {
+        return nil, "", false
+    }
+    return parts, alpha, true
+}
+
+// isRGBComponent accepts 0–255 or 0%–100%.
+func isRGBComponent(arg string) bool {
+    if strings.HasSuffix(arg, "%") {
+        return isPercentage(arg)
+    }
+    n, err := strconv.ParseFloat(arg, 64)
+    return err == nil && n >= 0 && n <= 255
+}
+
+// isColorAlpha accepts 0–1 or 0%–100%.
+func isColorAlpha(arg string) bool {
+    if strings.HasSuffix(arg, "%") {
+        return isPercentage(arg)
+    }
+    n, err := strconv.ParseFloat(arg, 64)
+    return err == nil && n >= 0 && n <= 1
+}
+
+// isPercentage accepts 0%–100%.
+func isPercentage(arg string) bool {
+    number, found := strings.CutSuffix(arg, "%")
+    if !found {
+        return false
+    }
+    n, err := strconv.ParseFloat(number, 64)
+    return err == nil && n >= 0 && n <= 100
+}
+
+// isHue accepts any number of degrees, optionally with the "deg" unit.
+func isHue(arg string) bool {
+    number, _ := strings.CutSuffix(strings.ToLower(arg), "deg")
+    _, err := strconv.ParseFloat(number, 64)
+    return err == nil
+}
+
+// cssNamedColors is the set of CSS named colors, plus "transparent".
+var cssNamedColors = map[string]bool{
+    "aliceblue": true, "antiquewhite": true, "aqua": true, "aquamarine": true, "azure": true,
+    "beige": true, "bisque": true, "black": true, "blanchedalmond": true, "blue": true,
+    "blueviolet": true, "brown": true, "burlywood": true, "cadetblue": true, "chartreuse": true,
+    "chocolate": true, "coral": true, "cornflowerblue": true, "cornsilk": true, "crimson": true,
+    "cyan": true, "darkblue": true, "darkcyan": true, "darkgoldenrod": true, "darkgray": true,
+    "darkgreen": true, "darkgrey": true, "darkkhaki": true, "darkmagenta": true, "darkolivegreen": true,
+    "darkorange": true, "darkorchid": true, "darkred": true, "darksalmon": true, "darkseagreen": true,
+    "darkslateblue": true, "darkslategray": true, "darkslategrey": true, "darkturquoise": true, "darkviolet": true,
+    "deeppink": true, "deepskyblue": true, "dimgray": true, "dimgrey": true, "dodgerblue": true,
+    "firebrick": true, "floralwhite": true, "forestgreen": true, "fuchsia": true, "gainsboro": true,
+    "ghostwhite": true, "gold": true, "goldenrod": true, "gray": true, "green": true,
+    "greenyellow": true, "grey": true, "honeydew": true, "hotpink": true, "indianred": true,
+    "indigo": true, "ivory": true, "khaki": true, "lavender": true, "lavenderblush": true,
+    "lawngreen": true, "lemonchiffon": true, "lightblue": true, "lightcoral": true, "lightcyan": true,
+    "lightgoldenrodyellow": true, "lightgray": true, "lightgreen": true, "lightgrey": true, "lightpink": true,
+    "lightsalmon": true, "lightseagreen": true, "lightskyblue": true, "lightslategray": true, "lightslategrey": true,
+    "lightsteelblue": true, "lightyellow": true, "lime": true, "limegreen": true, "linen": true,
+    "magenta": true, "maroon": true, "mediumaquamarine": true, "mediumblue": true, "mediumorchid": true,
+    "mediumpurple": true, "mediumseagreen": true, "mediumslateblue": true, "mediumspringgreen": true, "mediumturquoise": true,
+    "mediumvioletred": true, "midnightblue": true, "mintcream": true, "mistyrose": true, "moccasin": true,
+    "navajowhite": true, "navy": true, "oldlace": true, "olive": true, "olivedrab": true,
+    "orange": true, "orangered": true, "orchid": true, "palegoldenrod": true, "palegreen": true,
+    "paleturquoise": true, "palevioletred": true, "papayawhip": true, "peachpuff": true, "peru": true,
+    "pink": true, "plum": true, "powderblue": true, "purple": true, "rebeccapurple": true,
+    "red": true, "rosybrown": true, "royalblue": true, "saddlebrown": true, "salmon": true,
+    "sandybrown": true, "seagreen": true, "seashell": true, "sienna": true, "silver": true,
+    "skyblue": true, "slateblue": true, "slategray": true, "slategrey": true, "snow": true,
+    "springgreen": true, "steelblue": true, "tan": true, "teal": true, "thistle": true,
+    "tomato": true, "turquoise": true, "violet": true, "wheat": true, "white": true,
+    "whitesmoke": true, "yellow": true, "yellowgreen": true, "transparent": true,
+}
@@ -0,0 +1,112 @@
+package validator
+
+import "fmt"
+
+// GreaterThanField validates that the value is greater than the value of
+// `other`, such as a maximum price compared with a minimum price. Both
+// values must be numbers (any kind accepted by Number) or both times
+// (time.Time or *time.Time). The comparison uses the values at Validate
+// time; register `other` first when its rules convert its value, as
+// IntegerString does. The check is skipped while `other` is empty, leaving that to its
+// own rules, and values that can't be compared produce a single error on
+// this field.
+// Accepts an optional custom error message.
+//
+// Example:
+//    min := v.Field(req.MinPrice, "Min Price").Number()
+//    v.Field(req.MaxPrice, "Max Price").Number().GreaterThanField(min)
+func (f *Field) GreaterThanField(other *Field, messages ...string) *Field {
+    return f.compareField("gtField", other, "greater than", func(c int) bool { return c > 0 }, messages)
+}
+
+// GreaterOrEqualField validates that the value is greater than or equal
+// to the value of `other`. See GreaterThanField.
+// Accepts an optional custom error message.
+//
+// Example:
+//    v.Field(req.MaxPrice, "Max Price").GreaterOrEqualField(min)
+//    // "Max Price must be greater than or equal to Min Price"
+func (f *Field) GreaterOrEqualField(other *Field, messages ...string) *Field {
+    return f.compareField("gteField", other, "greater than or equal to", func(c int) bool { return c >= 0 }, messages)
+}
+
+// LessThanField validates that the value is less than the value of
+// `other`. See GreaterThanField.
+// Accepts an optional custom error message.
+//
+// Example:
+//    end := v.Field(req.EndsAt, "End Date")
+//    v.Field(req.StartsAt, "Start Date").LessThanField(end)
+func (f *Field) LessThanField(other *Field, messages ...string) *Field {
+    return f.compareField("ltField", other, "less than", func(c int) bool { return c < 0 }, messages)
+}
+
+// LessOrEqualField validates that the value is less than or equal to the
+// value of `other`. See GreaterThanField.
+// Accepts an optional custom error message.
+//
+// Example:
+//    v.Field(req.Quantity, "Quantity").LessOrEqualField(stock)
+func (f *Field) LessOrEqualField(other *Field, messages ...string) *Field {
+    return f.compareField("lteField", other, "less than or equal to", func(c int) bool { return c <= 0 }, messages)
+}
+
+// compareField adds a rule named `name` comparing the value of f with the
+// value of `other`; `ok` receives -1, 0 or 1 as the value of f is less
+// than, equal to or greater than the other value.
+func (f *Field) compareField(name string, other *Field, relation string, ok func(c int) bool, messages []string) *Field {
+    f.addRule(name, []interface{}{other.fieldKey()}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if isEmpty(other.value) {
+            return nil
+        }
+
+        c, valid, comparable := compareValues(f.value, other.value)
+        var err error
+        switch {
+        case !valid:
+            err = fmt.Errorf("%s must be a number or a time", f.name)
+        case !comparable:
+            err = fmt.Errorf("%s cannot be compared with %s", f.name, other.name)
+        case !ok(c):
+            err = fmt.Errorf("%s must be %s %s", f.name, relation, other.name)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// compareValues compares two numbers or two times, returning -1, 0 or 1.
+// valid is false when `a` is neither a number nor a time, and comparable
+// is false when `b` is not of the same sort as `a`.
+func compareValues(a, b interface{}) (c int, valid, comparable bool) {
+    if af, ok := toFloat64(a); ok {
+        bf, ok := toFloat64(b)
+        switch {
+        case !ok:
+            return 0, true, false
+        case af < bf:
+            return -1, true, true
+        case af > bf:
+            return 1, true, true
+        }
+        return 0, true, true
+    }
+
+    if at, ok := timeValue(a); ok {
+        bt, ok := timeValue(b)
+        if !ok {
+            return 0, true, false
+        }
+        return at.Compare(bt), true, true
+    }
+    return 0, false, false
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "math/big"
+    "reflect"
+    "strconv"
+    "strings"
+)
+
+// CheckComputed registers a field named `name` whose only rule is `fn`, for
+// invariants that span several values, such as a total matching its line
+// items. fn returns nil when the invariant holds, or an error that is
+// reported as is under `name`, like a Custom rule. The check runs in
+// registration order with the other fields, so stopOnFirst, ValidateN,
+// Warn, WithMessage and WithCode apply to it as usual. It is checked even
+// when the fields it reads failed, so fn should tolerate invalid values.
+// Since fn reads values the validator doesn't see, a validator with a
+// computed check runs again on every Validate instead of reusing its last
+// result.
+//
+// Example:
+//    v.CheckComputed("Discount", func() error {
+//        if order.Discount > order.Subtotal {
+//            return errors.New("Discount cannot exceed the subtotal")
+//        }
+//        return nil
+//    })
+func (v *Validator) CheckComputed(name string, fn func() error) *Field {
+    f := v.Field(nil, name)
+    f.addRule("computed", nil, func(f *Field) error {
+        return fn()
+    })
+    return f
+}
+
+// ProductEquals registers a computed check, under `name`, that `total`
+// equals `unit` times `qty`, give or take `epsilon`, such as the line total
+// of a checkout payload. The values may be integers, floats, or decimal
+// strings such as "19.99" (or json.Numbers), which are compared exactly,
+// and each may be a *Field, whose value is read when the check runs, after
+// the rules of that field converted it. A value that is not a number fails
+// the check. A negative epsilon is a *ConfigError (see Err).
+//
+// Example:
+//    v.ProductEquals(line.Total, line.UnitPrice, line.Quantity, 0.005, "Line Total")
+//    // Line Total must equal 59.97, the unit price times the quantity
+func (v *Validator) ProductEquals(total, unit, qty interface{}, epsilon float64, name string) *Field {
+    tolerance, ok := exactNumber(epsilon)
+    var err error
+    if !ok || epsilon < 0 {
+        err = fmt.Errorf("epsilon must be a finite number of at least 0, got %v", epsilon)
+    }
+
+    f := v.CheckComputed(name, func() error {
+        t, tOK := exactNumber(total)
+        u, uOK := exactNumber(unit)
+        q, qOK := exactNumber(qty)
+        if !tOK || !uOK || !qOK {
+            return fmt.Errorf("%s cannot be checked: the total, unit price and quantity must be numbers", name)
+        }
+
+        product := new(big.Rat).Mul(u.value, q.value)
+        diff := new(big.Rat).Sub(t.value, product)
+        if diff.Abs(diff).Cmp(tolerance.value) > 0 {
+            return fmt.Errorf("%s must equal %s, the unit price times the quantity", name, product.FloatString(u.decimals+q.decimals))
+        }
+        return nil
+    })
+    return f.misconfigured(err)
+}
+
+// exact is a number converted without rounding, with the number of decimal
+// places it was written with.
+type exact struct {
+    value    *big.Rat
+    decimals int
+}
+
+// exactNumber converts an integer, a finite float, or a decimal string or
+// json.Number to an exact number. A *Field is replaced by its value.
+func exactNumber(value interface{}) (exact, bool) {
+    if f, ok := value.(*Field); ok {
+        value = f.Value()
+    }
+
+    if str, ok := numericText(value); ok {
+        if !decimalPattern.MatchString(str) {
+            return exact{}, false
+        }
+        r, ok := new(big.Rat).SetString(str)
+        _, fraction, _ := strings.Cut(str, ".")
+        return exact{r, len(fraction)}, ok
+    }
+
+    rv := reflect.ValueOf(value)
+    switch rv.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return exact{new(big.Rat).SetInt64(rv.Int()), 0}, true
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        return exact{new(big.Rat).SetUint64(rv.Uint()), 0}, true
+    case reflect.Float32, reflect.Float64:
+        fv := rv.Float()
+        if math.IsNaN(fv) || math.IsInf(fv, 0) {
+            return exact{}, false
+        }
+        // The float is taken as the decimal it prints as, so 0.1 is 1/10.
+        text := strconv.FormatFloat(fv, 'f', -1, 64)
+        if rv.Kind() == reflect.Float32 {
+            text = strconv.FormatFloat(fv, 'f', -1, 32)
+        }
+        r, _ := new(big.Rat).SetString(text)
+        _, fraction, _ := strings.Cut(text, ".")
+        return exact{r, len(fraction)}, true
+    }
+    return exact{}, false
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestCheckComputedRunsAgain(t *testing.T) {
+    discount := 5
+    calls := 0
+    v := New()
+    v.CheckComputed("Discount", func() error {
+        calls++
+        if discount > 10 {
+            return errors.New("Discount cannot exceed 10")
+        }
+        return nil
+    })
+
+    if errs := v.Validate(false); errs != nil {
+        t.Fatalf("unexpected errors: %v", errs)
+    }
+
+    discount = 50
+    want := "Discount cannot exceed 10"
+    if got := firstError(v); got != want || calls != 2 {
+        t.Errorf("after the discount changed: got %q after %d calls, want %q after 2", got, calls, want)
+    }
+}
+
+func TestProductEquals(t *testing.T) {
+    tests := []struct {
+        total, unit, qty interface{}
+        message          string
+    }{
+        {"59.97", "19.99", 3, ""},
+        {59.97, 19.99, 3, ""},
+        {"59.98", "19.99", 3, "Line Total must equal 59.97, the unit price times the quantity"},
+        {"abc", "19.99", 3, "Line Total cannot be checked: the total, unit price and quantity must be numbers"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.ProductEquals(tt.total, tt.unit, tt.qty, 0, "Line Total")
+        if got := firstError(v); got != tt.message {
+            t.Errorf("ProductEquals(%v, %v, %v): got %q, want %q", tt.total, tt.unit, tt.qty, got, tt.message)
+        }
+    }
+}
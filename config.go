@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConfigError reports a rule that was registered with arguments it cannot
+// work with, such as an invalid pattern passed to Matches, an unknown time
+// zone passed to WithinHours or a minimum above the maximum in
+// Int64Between; the documentation of each rule tells which arguments it
+// rejects. It is a mistake in the program, not in the input, so it should
+// be logged and answered with a 500 rather than shown to the user.
+// Validator.Err returns the ConfigErrors of a validator, and validating it
+// fails with them before any rule is checked.
+type ConfigError struct {
+    // Field is the key of the field the rule was registered on.
+    Field string
+    // Rule is the name of the misconfigured rule, such as "matches".
+    Rule string
+    // Err describes what is wrong with the rule's arguments.
+    Err error
+}
+
+// Error implements the error interface.
+func (e *ConfigError) Error() string {
+    return fmt.Sprintf("validator: %s rule on %s is misconfigured: %v", e.Rule, e.Field, e.Err)
+}
+
+// Unwrap returns the problem with the rule's arguments.
+func (e *ConfigError) Unwrap() error {
+    return e.Err
+}
+
+// Err returns the misconfigured rules of v as *ConfigErrors joined with
+// errors.Join, or nil when every rule can be checked. The rules are checked
+// when they are registered, so Err can be called once at startup, when v is
+// built from constants, or before Validate when its arguments come from
+// settings. Validate returns the same error as its only element, and
+// ValidateContext and Result.Err as their error, without checking any rule,
+// so a programming mistake is never reported as a problem with the input.
+//
+// Example:
+//
+//    v.Field(req.Code, "Code").Matches(settings.CodePattern)
+//    if err := v.Err(); err != nil {
+//        http.Error(w, "internal error", http.StatusInternalServerError)
+//        return
+//    }
+//    if errs := v.Validate(false); errs != nil {
+//        // respond with 422
+//    }
+func (v *Validator) Err() error {
+    var errs []error
+    for _, f := range v.root().fields {
+        for _, r := range f.rules {
+            if r.config != nil {
+                errs = append(errs, &ConfigError{Field: f.fieldKey(), Rule: r.name, Err: r.config})
+            }
+        }
+    }
+    return errors.Join(errs...)
+}
+
+// misconfigured records that the rule just added to f cannot be checked
+// because of err, a problem with its arguments. It does nothing when err
+// is nil.
+func (f *Field) misconfigured(err error) *Field {
+    if err != nil && len(f.rules) > 0 {
+        f.rules[len(f.rules)-1].config = err
+    }
+    return f
+}
+
+// boundsError returns the error for a rule registered with a minimum
+// above its maximum, or nil.
+func boundsError[T Number](min, max T) error {
+    if min > max {
+        return fmt.Errorf("minimum %v is greater than maximum %v", min, max)
+    }
+    return nil
+}
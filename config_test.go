@@ -0,0 +1,63 @@
+package validator
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestConfigErrors(t *testing.T) {
+    tests := []struct {
+        name string
+        rule func(f *Field)
+        bad  bool
+    }{
+        {"phone region", func(f *Field) { f.PhoneForRegion("ZZ") }, true},
+        {"phone region", func(f *Field) { f.PhoneForRegion("fr") }, false},
+        {"currency", func(f *Field) { f.AmountForCurrency("ZZZ") }, true},
+        {"currency", func(f *Field) { f.AmountForCurrency("JPY") }, false},
+        {"amount format", func(f *Field) { f.AmountIn(AmountFormat{DecimalSeparator: ".", Currency: "ZZZ"}) }, true},
+        {"national ID country", func(f *Field) { f.NationalID("XX") }, true},
+        {"national ID country", func(f *Field) { f.NationalID("ng") }, false},
+        {"VAT country", func(f *Field) { f.VATForCountry("ZZ") }, true},
+        {"VAT country", func(f *Field) { f.VATForCountry("US") }, true},
+        {"VAT country", func(f *Field) { f.VATForCountry("GR") }, false},
+        {"VAT country", func(f *Field) { f.VATForCountry("") }, false},
+        {"color formats", func(f *Field) { f.ColorIn(0) }, true},
+        {"color formats", func(f *Field) { f.ColorIn(ColorFormatHex) }, false},
+        {"username policy", func(f *Field) { f.Username(UsernamePolicy{MinLen: 10, MaxLen: 3}) }, true},
+        {"username policy", func(f *Field) { f.Username(UsernamePolicy{MinLen: 10}) }, false},
+        {"username policy", func(f *Field) { f.Username() }, false},
+        {"pattern", func(f *Field) { f.Matches("[") }, true},
+        {"bounds", func(f *Field) { f.Int64Between(10, 1) }, true},
+        {"bit width", func(f *Field) { f.SignedCompatible(12) }, true},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        tt.rule(v.Field("value", "Field"))
+
+        err := v.Err()
+        var cfgErr *ConfigError
+        if bad := errors.As(err, &cfgErr); bad != tt.bad {
+            t.Errorf("%s: Err() = %v, want a *ConfigError: %v", tt.name, err, tt.bad)
+            continue
+        }
+        if !tt.bad {
+            continue
+        }
+
+        // Validating fails with the ConfigError alone, not with a
+        // ValidationError about the value.
+        errs := v.Validate(false)
+        if len(errs) != 1 || !errors.As(errs[0], &cfgErr) {
+            t.Errorf("%s: Validate() = %v, want only the *ConfigError", tt.name, errs)
+        }
+        var verr ValidationError
+        if errors.As(errs[0], &verr) {
+            t.Errorf("%s: the ConfigError is a ValidationError", tt.name)
+        }
+        if _, err := v.ValidateContext(t.Context(), false); !errors.As(err, &cfgErr) {
+            t.Errorf("%s: ValidateContext() error = %v, want the *ConfigError", tt.name, err)
+        }
+    }
+}
@@ -0,0 +1,292 @@
+package validator
+
+import (
+    "bytes"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "math/big"
+    "math/bits"
+    "regexp"
+    "strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Checksum constants of bech32 (segwit version 0) and bech32m (version 1
+// and later) addresses, from BIP 173 and BIP 350.
+const (
+    bech32Const  = 1
+    bech32mConst = 0x2bc830a3
+)
+
+var ethAddressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// BTCAddress validates that the value is a Bitcoin mainnet address: a
+// legacy P2PKH ("1...") or P2SH ("3...") address with a valid Base58Check
+// checksum, or a segwit address ("bc1...") with a valid bech32 checksum
+// (bech32m for taproot and later witness versions).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.BTCAddress()
+//    f.BTCAddress("Payout address is not a Bitcoin address")
+func (f *Field) BTCAddress(messages ...string) *Field {
+    f.addRule("btcAddress", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !(isBase58CheckAddress(str) || isSegwitAddress(str)) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid Bitcoin address", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// ETHAddress validates that the value is an Ethereum address: "0x"
+// followed by 40 hex digits. Mixed-case addresses must carry a valid
+// EIP-55 checksum; all lowercase and all uppercase ones have none to check.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ETHAddress()
+//    f.ETHAddress("Payout address is not an Ethereum address")
+func (f *Field) ETHAddress(messages ...string) *Field {
+    f.addRule("ethAddress", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isETHAddress(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid Ethereum address", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isBase58CheckAddress reports whether str is a Base58Check encoded
+// P2PKH or P2SH mainnet address with a matching checksum.
+func isBase58CheckAddress(str string) bool {
+    if len(str) < 26 || len(str) > 35 {
+        return false
+    }
+
+    n := new(big.Int)
+    radix := big.NewInt(58)
+    for _, r := range str {
+        digit := strings.IndexRune(base58Alphabet, r)
+        if digit < 0 {
+            return false
+        }
+        n.Mul(n, radix)
+        n.Add(n, big.NewInt(int64(digit)))
+    }
+
+    // Each leading "1" encodes a leading zero byte.
+    decoded := append(bytes.Repeat([]byte{0}, len(str)-len(strings.TrimLeft(str, "1"))), n.Bytes()...)
+    if len(decoded) != 25 || (decoded[0] != 0x00 && decoded[0] != 0x05) {
+        return false
+    }
+
+    first := sha256.Sum256(decoded[:21])
+    second := sha256.Sum256(first[:])
+    return bytes.Equal(second[:4], decoded[21:])
+}
+
+// isSegwitAddress reports whether str is a bech32 or bech32m encoded
+// mainnet segwit address with a matching checksum.
+func isSegwitAddress(str string) bool {
+    if len(str) < 14 || len(str) > 90 || (strings.ToLower(str) != str && strings.ToUpper(str) != str) {
+        return false
+    }
+    str = strings.ToLower(str)
+    if !strings.HasPrefix(str, "bc1") {
+        return false
+    }
+
+    data := make([]byte, 0, len(str)-3)
+    for _, r := range str[3:] {
+        value := strings.IndexRune(bech32Charset, r)
+        if value < 0 {
+            return false
+        }
+        data = append(data, byte(value))
+    }
+    if len(data) < 7 {
+        return false
+    }
+
+    version := data[0]
+    checksum := bech32Polymod(append([]byte{3, 3, 0, 2, 3}, data...))
+    if version > 16 || (version == 0 && checksum != bech32Const) || (version > 0 && checksum != bech32mConst) {
+        return false
+    }
+
+    program, ok := convertBits(data[1:len(data)-6], 5, 8)
+    if !ok || len(program) < 2 || len(program) > 40 {
+        return false
+    }
+    return version != 0 || len(program) == 20 || len(program) == 32
+}
+
+// bech32Polymod computes the bech32 checksum of expanded human-readable
+// part and data values.
+func bech32Polymod(values []byte) uint32 {
+    generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+    chk := uint32(1)
+    for _, v := range values {
+        top := chk >> 25
+        chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+        for i := 0; i < 5; i++ {
+            if (top>>i)&1 == 1 {
+                chk ^= generator[i]
+            }
+        }
+    }
+    return chk
+}
+
+// convertBits regroups values of `from` bits into values of `to` bits,
+// rejecting non-zero or overlong padding.
+func convertBits(data []byte, from, to uint) ([]byte, bool) {
+    var acc, bitCount uint
+    out := make([]byte, 0, len(data)*int(from)/int(to))
+    for _, value := range data {
+        acc = acc<<from | uint(value)
+        bitCount += from
+        for bitCount >= to {
+            bitCount -= to
+            out = append(out, byte(acc>>bitCount&(1<<to-1)))
+        }
+    }
+    if bitCount >= from || acc&(1<<bitCount-1) != 0 {
+        return nil, false
+    }
+    return out, true
+}
+
+// isETHAddress reports whether str is an Ethereum address whose EIP-55
+// checksum, if any, matches.
+func isETHAddress(str string) bool {
+    if !ethAddressPattern.MatchString(str) {
+        return false
+    }
+
+    digits := str[2:]
+    lower := strings.ToLower(digits)
+    if digits == lower || digits == strings.ToUpper(digits) {
+        return true
+    }
+
+    // A letter is uppercase when the matching nibble of the Keccak-256
+    // hash of the lowercase address is 8 or more.
+    hash := hex.EncodeToString(keccak256([]byte(lower)))
+    for i := 0; i < len(digits); i++ {
+        c := digits[i]
+        if c >= '0' && c <= '9' {
+            continue
+        }
+        if upper := hash[i] >= '8'; upper != (c >= 'A' && c <= 'F') {
+            return false
+        }
+    }
+    return true
+}
+
+// keccakRoundConstants are the round constants of Keccak-f[1600].
+var keccakRoundConstants = [24]uint64{
+    0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+    0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+    0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+    0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+    0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+    0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRotations are the rotation offsets of the rho step, indexed by
+// lane x + 5*y.
+var keccakRotations = [25]int{
+    0, 1, 62, 28, 27,
+    36, 44, 6, 55, 20,
+    3, 10, 43, 25, 39,
+    41, 45, 15, 21, 8,
+    18, 2, 61, 56, 14,
+}
+
+// keccak256 returns the original Keccak-256 hash of data, as used by
+// Ethereum. It differs from SHA3-256 in crypto/sha3 only in its padding.
+func keccak256(data []byte) []byte {
+    const rate = 136
+    var state [25]uint64
+
+    padded := append(append([]byte(nil), data...), 0x01)
+    for len(padded)%rate != 0 {
+        padded = append(padded, 0)
+    }
+    padded[len(padded)-1] |= 0x80
+
+    for block := padded; len(block) > 0; block = block[rate:] {
+        for i := 0; i < rate/8; i++ {
+            for b := 0; b < 8; b++ {
+                state[i] ^= uint64(block[i*8+b]) << (8 * b)
+            }
+        }
+        keccakF1600(&state)
+    }
+
+    out := make([]byte, 32)
+    for i := range out {
+        out[i] = byte(state[i/8] >> (8 * (i % 8)))
+    }
+    return out
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation to state.
+func keccakF1600(state *[25]uint64) {
+    for round := 0; round < 24; round++ {
+        // Theta
+        var c [5]uint64
+        for x := 0; x < 5; x++ {
+            c[x] = state[x] ^ state[x+5] ^ state[x+10] ^ state[x+15] ^ state[x+20]
+        }
+        for x := 0; x < 5; x++ {
+            d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+            for y := 0; y < 25; y += 5 {
+                state[x+y] ^= d
+            }
+        }
+
+        // Rho and pi
+        var b [25]uint64
+        for x := 0; x < 5; x++ {
+            for y := 0; y < 5; y++ {
+                b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(state[x+5*y], keccakRotations[x+5*y])
+            }
+        }
+
+        // Chi
+        for y := 0; y < 25; y += 5 {
+            for x := 0; x < 5; x++ {
+                state[x+y] = b[x+y] ^ (^b[(x+1)%5+y] & b[(x+2)%5+y])
+            }
+        }
+
+        // Iota
+        state[0] ^= keccakRoundConstants[round]
+    }
+}
@@ -0,0 +1,68 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestBTCAddress(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        // P2PKH, P2SH, segwit v0 (BIP 173) and taproot (BIP 350).
+        {"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true},
+        {"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", true},
+        {"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", true},
+        {"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", true},
+        {"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", true},
+        {"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", true},
+
+        // The same addresses with one character changed.
+        {"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", false},
+        {"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLz", false},
+        {"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", false},
+        {"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv4", false},
+        {"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj1", false},
+
+        // Mixed case, a testnet prefix and a version 1 address with a
+        // bech32 instead of a bech32m checksum (BIP 350).
+        {"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kV8f3t4", false},
+        {"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", false},
+        {"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7k7grplx", false},
+        {"", false},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, func(f *Field) { f.BTCAddress() }); valid != tt.valid {
+            t.Errorf("BTCAddress(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestETHAddress(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        // EIP-55 vectors.
+        {"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+        {"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", true},
+        {"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", true},
+        {"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", true},
+        {"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+        {"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", true},
+
+        // One letter with the wrong case, or one hex digit changed.
+        {"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", false},
+        {"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d35a", false},
+        {"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe", false},
+        {"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+        {"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", false},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, func(f *Field) { f.ETHAddress() }); valid != tt.valid {
+            t.Errorf("ETHAddress(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
@@ -0,0 +1,181 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// currencyMinorUnits maps active ISO 4217 currency codes to their minor
+// unit, the number of decimal places used by the currency.
+var currencyMinorUnits = map[string]int{
+    "AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2, "AUD": 2,
+    "AWG": 2, "AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BHD": 3, "BIF": 0,
+    "BMD": 2, "BND": 2, "BOB": 2, "BOV": 2, "BRL": 2, "BSD": 2, "BTN": 2, "BWP": 2,
+    "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2, "CHF": 2, "CHW": 2, "CLF": 4,
+    "CLP": 0, "CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUC": 2, "CUP": 2, "CVE": 2,
+    "CZK": 2, "DJF": 0, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2,
+    "EUR": 2, "FJD": 2, "FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2,
+    "GNF": 0, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2, "HUF": 2, "IDR": 2,
+    "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0, "JMD": 2, "JOD": 3, "JPY": 0,
+    "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0, "KPW": 2, "KRW": 0, "KWD": 3, "KYD": 2,
+    "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2,
+    "MDL": 2, "MGA": 2, "MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2,
+    "MVR": 2, "MWK": 2, "MXN": 2, "MXV": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2,
+    "NIO": 2, "NOK": 2, "NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2, "PGK": 2,
+    "PHP": 2, "PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2,
+    "RWF": 0, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2,
+    "SLE": 2, "SLL": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SVC": 2, "SYP": 2,
+    "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TND": 3, "TOP": 2, "TRY": 2, "TTD": 2,
+    "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2, "USN": 2, "UYI": 0, "UYU": 2,
+    "UYW": 4, "UZS": 2, "VED": 2, "VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0,
+    "XCD": 2, "XCG": 2, "XOF": 0, "XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+    "ZWL": 2,
+}
+
+// CurrencyCode validates that the value is an active ISO 4217 currency
+// code in upper case, such as "USD" or "NGN".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.CurrencyCode()
+//    f.CurrencyCode("Unsupported currency")
+func (f *Field) CurrencyCode(messages ...string) *Field {
+    f.addRule("currencyCode", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if _, known := currencyMinorUnits[str]; !ok || !known {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid ISO 4217 currency code", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// AmountFormat describes how monetary amounts are written.
+type AmountFormat struct {
+    // ThousandsSeparator may separate groups of three digits, e.g. "," in
+    // "1,234.56". Leave it empty to reject grouping.
+    ThousandsSeparator string
+    // DecimalSeparator separates the fraction, e.g. "." in "1,234.56".
+    DecimalSeparator string
+    // AllowNegative accepts a leading minus sign.
+    AllowNegative bool
+    // Currency, when set to an ISO 4217 code, limits the number of decimal
+    // places to the currency's minor unit (0 for JPY, 2 for USD, 3 for BHD).
+    Currency string
+}
+
+// DefaultAmountFormat accepts amounts such as "1234.56" and "1,234.56".
+var DefaultAmountFormat = AmountFormat{ThousandsSeparator: ",", DecimalSeparator: "."}
+
+// EuropeanAmountFormat accepts amounts such as "1234,56" and "1.234,56".
+var EuropeanAmountFormat = AmountFormat{ThousandsSeparator: ".", DecimalSeparator: ","}
+
+// Amount validates that the value is a monetary amount string in the
+// DefaultAmountFormat, such as "1234.56" or "1,234.56". Negative amounts
+// are rejected; use AmountIn to change the separators or allow them.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Amount()
+//    f.Amount("Enter an amount like 1,234.56")
+func (f *Field) Amount(messages ...string) *Field {
+    return f.AmountIn(DefaultAmountFormat, messages...)
+}
+
+// AmountForCurrency validates an amount in the DefaultAmountFormat whose
+// decimal places do not exceed the minor unit of the ISO 4217 currency
+// `code`: "1000" is a valid JPY amount but "1000.5" is not. A code that
+// is not a known ISO 4217 currency, such as "ZZZ", is a *ConfigError (see
+// Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.AmountForCurrency("JPY")
+//    f.AmountForCurrency(invoice.Currency, "Invalid amount for this currency")
+func (f *Field) AmountForCurrency(code string, messages ...string) *Field {
+    format := DefaultAmountFormat
+    format.Currency = code
+    return f.AmountIn(format, messages...)
+}
+
+// AmountIn validates that the value is a monetary amount string written
+// in `format`. Digit groups must be exactly three digits long when the
+// thousands separator is used. An unknown format.Currency is a
+// *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.AmountIn(validator.EuropeanAmountFormat)
+//    f.AmountIn(validator.AmountFormat{DecimalSeparator: ".", AllowNegative: true, Currency: "BHD"})
+func (f *Field) AmountIn(format AmountFormat, messages ...string) *Field {
+    pattern := amountPattern(format)
+    minorUnit, knownCurrency := currencyMinorUnits[format.Currency]
+
+    f.addRule("amount", []interface{}{format}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !pattern.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid amount", f.name)
+        }
+
+        if strings.HasPrefix(str, "-") && !format.AllowNegative {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot be negative", f.name)
+        }
+
+        if format.Currency != "" {
+            places := 0
+            if _, fraction, found := strings.Cut(str, format.DecimalSeparator); found {
+                places = len(fraction)
+            }
+            if places > minorUnit {
+                if message != "" {
+                    return fmt.Errorf("%s", message)
+                }
+                if minorUnit == 0 {
+                    return fmt.Errorf("%s cannot have decimal places in %s", f.name, format.Currency)
+                }
+                return fmt.Errorf("%s can have at most %d decimal places in %s", f.name, minorUnit, format.Currency)
+            }
+        }
+        return nil
+    })
+    var err error
+    if format.Currency != "" && !knownCurrency {
+        err = fmt.Errorf("currency %q is not a known ISO 4217 code", format.Currency)
+    }
+    return f.misconfigured(err)
+}
+
+// amountPattern builds the regular expression matching amounts in format.
+// The sign is matched here and checked separately for a clearer error.
+func amountPattern(format AmountFormat) *regexp.Regexp {
+    integer := `[0-9]+`
+    if format.ThousandsSeparator != "" {
+        integer = `(?:[0-9]+|[0-9]{1,3}(?:` + regexp.QuoteMeta(format.ThousandsSeparator) + `[0-9]{3})+)`
+    }
+
+    fraction := ""
+    if format.DecimalSeparator != "" {
+        fraction = `(?:` + regexp.QuoteMeta(format.DecimalSeparator) + `[0-9]+)?`
+    }
+    return regexp.MustCompile(`^-?` + integer + fraction + `$`)
+}
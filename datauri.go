@@ -0,0 +1,161 @@
+package validator
+
+import (
+    "fmt"
+    "mime"
+    "strings"
+)
+
+// DataURIOptions restricts the data URIs accepted by DataURIWith.
+type DataURIOptions struct {
+    // Types lists the allowed media types, such as "image/png", or
+    // "image/*" for any subtype. Parameters such as charset are ignored.
+    // Empty allows every media type.
+    Types []string
+    // MaxBytes bounds the size of the decoded payload. Zero means no limit.
+    MaxBytes int
+}
+
+// DataURI validates that the value is a data URI of the form
+// "data:[mediatype][;base64],payload". Base64 payloads must use the
+// standard alphabet with padding; other payloads are percent-encoded text,
+// as in "data:,Hello%20world". A missing media type means "text/plain".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DataURI()
+//    f.DataURI("Image must be a data URI")
+func (f *Field) DataURI(messages ...string) *Field {
+    return f.DataURIWith(DataURIOptions{}, messages...)
+}
+
+// DataURIWith is DataURI restricted to some media types and a maximum
+// decoded size. The size is computed from the encoded length, so large
+// payloads are never decoded just to be measured.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DataURIWith(validator.DataURIOptions{
+//        Types:    []string{"image/png", "image/jpeg"},
+//        MaxBytes: 2 << 20,
+//    })
+func (f *Field) DataURIWith(opts DataURIOptions, messages ...string) *Field {
+    f.addRule("dataURI", []interface{}{opts}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var err error
+        if ok {
+            var mediaType string
+            var size int
+            mediaType, size, ok = parseDataURI(str)
+            switch {
+            case !ok:
+            case len(opts.Types) > 0 && !mediaTypeAllowed(mediaType, opts.Types):
+                err = fmt.Errorf("%s must have one of the media types %s", f.name, strings.Join(opts.Types, ", "))
+            case opts.MaxBytes > 0 && size > opts.MaxBytes:
+                err = fmt.Errorf("%s cannot be larger than %d bytes", f.name, opts.MaxBytes)
+            }
+        }
+        if !ok {
+            err = fmt.Errorf("%s must be a valid data URI", f.name)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// parseDataURI returns the media type of a data URI and the decoded size of
+// its payload, reporting whether the URI is well formed.
+func parseDataURI(str string) (mediaType string, size int, ok bool) {
+    if len(str) < 5 || !strings.EqualFold(str[:5], "data:") {
+        return "", 0, false
+    }
+    header, payload, found := strings.Cut(str[5:], ",")
+    if !found {
+        return "", 0, false
+    }
+
+    base64 := len(header) >= 7 && strings.EqualFold(header[len(header)-7:], ";base64")
+    if base64 {
+        header = header[:len(header)-7]
+    }
+    if header == "" || strings.HasPrefix(header, ";") {
+        header = "text/plain" + header
+    }
+    mediaType, _, err := mime.ParseMediaType(header)
+    if err != nil || !strings.Contains(mediaType, "/") {
+        return "", 0, false
+    }
+
+    if base64 {
+        size, ok = base64DecodedLen(payload)
+    } else {
+        size, ok = percentDecodedLen(payload)
+    }
+    return mediaType, size, ok
+}
+
+// base64DecodedLen returns the number of bytes that the padded standard
+// base64 string s decodes to, without decoding it.
+func base64DecodedLen(s string) (int, bool) {
+    if len(s)%4 != 0 {
+        return 0, false
+    }
+
+    padding := len(s) - len(strings.TrimRight(s, "="))
+    if padding > 2 {
+        return 0, false
+    }
+    for i := 0; i < len(s)-padding; i++ {
+        c := s[i]
+        if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '+' || c == '/') {
+            return 0, false
+        }
+    }
+    return len(s)/4*3 - padding, true
+}
+
+// percentDecodedLen returns the number of bytes that the percent-encoded
+// string s decodes to, without decoding it.
+func percentDecodedLen(s string) (int, bool) {
+    size := 0
+    for i := 0; i < len(s); i++ {
+        if s[i] == '%' {
+            if i+2 >= len(s) || !isHexDigit(s[i+1]) || !isHexDigit(s[i+2]) {
+                return 0, false
+            }
+            i += 2
+        }
+        size++
+    }
+    return size, true
+}
+
+// isHexDigit reports whether c is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+    return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// mediaTypeAllowed reports whether mediaType matches one of `types`,
+// where "type/*" matches any subtype.
+func mediaTypeAllowed(mediaType string, types []string) bool {
+    for _, allowed := range types {
+        allowed = strings.ToLower(allowed)
+        if prefix, wildcard := strings.CutSuffix(allowed, "/*"); wildcard {
+            if strings.HasPrefix(mediaType, prefix+"/") {
+                return true
+            }
+        } else if mediaType == allowed {
+            return true
+        }
+    }
+    return false
+}
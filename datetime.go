@@ -0,0 +1,375 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "regexp"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// isoDurationPattern matches ISO 8601 durations in the
+// P[n]Y[n]M[n]DT[n]H[n]M[n]S form and the P[n]W weeks form. Numbers may
+// have a fraction, written with "." or ",".
+var isoDurationPattern = regexp.MustCompile(`^P(?:\d+(?:[.,]\d+)?W|(?:\d+(?:[.,]\d+)?Y)?(?:\d+(?:[.,]\d+)?M)?(?:\d+(?:[.,]\d+)?D)?(?:T(?:\d+(?:[.,]\d+)?H)?(?:\d+(?:[.,]\d+)?M)?(?:\d+(?:[.,]\d+)?S)?)?)$`)
+
+// RFC3339 validates that the string value is an RFC 3339 timestamp with
+// whole seconds and an explicit "Z" or numeric offset, such as
+// "2024-02-29T13:45:00Z" or "2024-02-29T13:45:00+01:00".
+// Use RFC3339Nano to also accept fractional seconds.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.RFC3339()
+//    f.RFC3339("CreatedAt must be an RFC 3339 timestamp")
+func (f *Field) RFC3339(messages ...string) *Field {
+    f.addRule("rfc3339", nil, func(f *Field) error {
+        return checkTimestamp(f, messages, false)
+    })
+    return f
+}
+
+// RFC3339Nano validates that the string value is an RFC 3339 timestamp
+// that may have fractional seconds, such as "2024-02-29T13:45:00.123Z".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.RFC3339Nano()
+func (f *Field) RFC3339Nano(messages ...string) *Field {
+    f.addRule("rfc3339Nano", nil, func(f *Field) error {
+        return checkTimestamp(f, messages, true)
+    })
+    return f
+}
+
+// checkTimestamp checks that the value of f is an RFC 3339 timestamp,
+// with fractional seconds only when `fraction` is set.
+func checkTimestamp(f *Field, messages []string, fraction bool) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, ok := f.value.(string)
+    if ok {
+        // time.Parse accepts fractional seconds even when the layout has
+        // none, so they are rejected separately.
+        _, err := time.Parse(time.RFC3339Nano, str)
+        ok = err == nil && (fraction || !strings.Contains(str, "."))
+    }
+
+    if !ok {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s must be a valid RFC 3339 timestamp", f.name)
+    }
+    return nil
+}
+
+// ISO8601Duration validates that the string value is an ISO 8601 duration
+// such as "P1DT2H", "PT0S", "PT1.5S" or "P3W". At least one component is
+// required, so "P" and "PT" are rejected, and only the last component may
+// have a fraction. Weeks can't be combined with other components.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ISO8601Duration()
+//    f.ISO8601Duration("Interval must look like P1DT2H")
+func (f *Field) ISO8601Duration(messages ...string) *Field {
+    f.addRule("iso8601Duration", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isISODuration(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid ISO 8601 duration", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isISODuration reports whether str is an ISO 8601 duration with at
+// least one component and at most a fraction on the last one.
+func isISODuration(str string) bool {
+    if !isoDurationPattern.MatchString(str) || str == "P" || strings.HasSuffix(str, "T") {
+        return false
+    }
+
+    if i := strings.IndexAny(str, ".,"); i >= 0 {
+        // Everything after the fraction must be its digits and a designator.
+        rest := strings.TrimLeft(str[i+1:], "0123456789")
+        return len(rest) == 1
+    }
+    return true
+}
+
+// isoDurationValue converts an ISO 8601 duration, as accepted by
+// ISO8601Duration, to a time.Duration, counting a week as 7 days and a day
+// as 24 hours. It reports false for durations with years or months, whose
+// length depends on the date they start at, and for durations that
+// overflow a time.Duration.
+func isoDurationValue(str string) (time.Duration, bool) {
+    if !isISODuration(str) {
+        return 0, false
+    }
+
+    var total time.Duration
+    number, inTime := "", false
+    for _, r := range str[1:] {
+        switch {
+        case r == 'T':
+            inTime = true
+            continue
+        case r >= '0' && r <= '9' || r == '.' || r == ',':
+            number += string(r)
+            continue
+        }
+
+        var unit time.Duration
+        switch {
+        case r == 'W':
+            unit = 7 * 24 * time.Hour
+        case r == 'D':
+            unit = 24 * time.Hour
+        case r == 'H':
+            unit = time.Hour
+        case r == 'M' && inTime:
+            unit = time.Minute
+        case r == 'S':
+            unit = time.Second
+        default:
+            // Years and months.
+            return 0, false
+        }
+
+        whole, fraction, _ := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+        n, err := strconv.ParseInt(whole, 10, 64)
+        if err != nil || n > int64(math.MaxInt64/unit) {
+            return 0, false
+        }
+        d := time.Duration(n) * unit
+        if fraction != "" {
+            f, _ := strconv.ParseFloat("0."+fraction, 64)
+            d += time.Duration(math.Round(f * float64(unit)))
+        }
+        if d > math.MaxInt64-total {
+            return 0, false
+        }
+        total += d
+        number = ""
+    }
+    return total, true
+}
+
+// MinTime validates that the value is a time.Time or *time.Time that is
+// not before `min`. Monotonic clock readings are ignored, so the
+// comparison only depends on the wall clock instant.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinTime(time.Now())
+//    f.MinTime(launch, "Start must not be before the launch")
+func (f *Field) MinTime(min time.Time, messages ...string) *Field {
+    return f.timeRange("minTime", &min, nil, messages)
+}
+
+// MaxTime validates that the value is a time.Time or *time.Time that is
+// not after `max`.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxTime(time.Now())
+func (f *Field) MaxTime(max time.Time, messages ...string) *Field {
+    return f.timeRange("maxTime", nil, &max, messages)
+}
+
+// TimeBetween validates that the value is a time.Time or *time.Time
+// between `from` and `to`, inclusive at both ends.
+// A `from` after `to` is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.TimeBetween(season.Start, season.End)
+func (f *Field) TimeBetween(from, to time.Time, messages ...string) *Field {
+    var boundsErr error
+    if from.After(to) {
+        boundsErr = fmt.Errorf("start %s is after end %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+    }
+    return f.timeRange("timeBetween", &from, &to, messages).misconfigured(boundsErr)
+}
+
+// NotZeroTime validates that the value is a time.Time or *time.Time that
+// is set, since a zero time usually means a missing value after decoding.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NotZeroTime().MinTime(time.Now())
+func (f *Field) NotZeroTime(messages ...string) *Field {
+    f.addRule("notZeroTime", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        t, ok := timeValue(f.value)
+        if !ok || t.IsZero() {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s is required", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// timeRange adds a rule named `name` checking that the time value is
+// within the bounds that are not nil.
+func (f *Field) timeRange(name string, min, max *time.Time, messages []string) *Field {
+    var params []interface{}
+    for _, bound := range []*time.Time{min, max} {
+        if bound != nil {
+            *bound = bound.Round(0)
+            params = append(params, *bound)
+        }
+    }
+
+    f.addRule(name, params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        t, ok := timeValue(f.value)
+        var err error
+        switch {
+        case !ok:
+            err = fmt.Errorf("%s must be a time", f.name)
+        case min != nil && max != nil && (t.Before(*min) || t.After(*max)):
+            err = fmt.Errorf("%s must be between %s and %s", f.name, min.Format(time.RFC3339), max.Format(time.RFC3339))
+        case min != nil && t.Before(*min):
+            err = fmt.Errorf("%s must not be before %s", f.name, min.Format(time.RFC3339))
+        case max != nil && t.After(*max):
+            err = fmt.Errorf("%s must not be after %s", f.name, max.Format(time.RFC3339))
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// timeValue returns value as a time without a monotonic clock reading,
+// accepting time.Time and non-nil *time.Time.
+func timeValue(value interface{}) (time.Time, bool) {
+    switch v := value.(type) {
+    case time.Time:
+        return v.Round(0), true
+    case *time.Time:
+        if v != nil {
+            return v.Round(0), true
+        }
+    }
+    return time.Time{}, false
+}
+
+// WithinHours validates that the value falls within the hours [start, end)
+// in the IANA time zone `tz`, such as "Africa/Lagos". start and end are
+// times of day written as "15:04" or "15:04:05"; when end is before start,
+// the range crosses midnight, so "22:00" to "06:00" accepts 23:30 and 05:59.
+//
+// The value may be a time.Time, a *time.Time or an RFC 3339 timestamp,
+// which are converted to `tz` first, or a time of day such as "09:30",
+// which is taken to be in `tz` already. An unknown zone or a malformed
+// start or end is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.WithinHours("09:00", "17:00", "Africa/Lagos")
+//    f.WithinHours("22:00", "06:00", "Europe/Berlin", "Night deliveries only")
+func (f *Field) WithinHours(start, end, tz string, messages ...string) *Field {
+    loc, err := time.LoadLocation(tz)
+    from, fromOK := clockSeconds(start)
+    to, toOK := clockSeconds(end)
+    var hoursErr error
+    switch {
+    case err != nil || tz == "":
+        hoursErr = fmt.Errorf("unknown time zone %q", tz)
+    case !fromOK:
+        hoursErr = fmt.Errorf("invalid start time %q", start)
+    case !toOK:
+        hoursErr = fmt.Errorf("invalid end time %q", end)
+    }
+
+    f.addRule("withinHours", []interface{}{start, end, tz}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        at, ok := timeOfDay(f.value, loc)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a time of day or an RFC 3339 timestamp", f.name)
+        }
+
+        inside := at >= from && at < to
+        if to < from {
+            inside = at >= from || at < to
+        }
+        if !inside {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be between %s and %s (%s)", f.name, start, end, tz)
+        }
+        return nil
+    })
+    return f.misconfigured(hoursErr)
+}
+
+// timeOfDay returns the seconds since midnight of value in loc. Times and
+// RFC 3339 timestamps are converted to loc; times of day are used as is.
+func timeOfDay(value interface{}, loc *time.Location) (int, bool) {
+    if str, ok := value.(string); ok {
+        if seconds, ok := clockSeconds(str); ok {
+            return seconds, true
+        }
+        t, err := time.Parse(time.RFC3339Nano, str)
+        if err != nil {
+            return 0, false
+        }
+        value = t
+    }
+
+    t, ok := timeValue(value)
+    if !ok {
+        return 0, false
+    }
+    hour, minute, second := t.In(loc).Clock()
+    return hour*3600 + minute*60 + second, true
+}
+
+// clockSeconds parses a time of day written as "15:04" or "15:04:05"
+// into seconds since midnight.
+func clockSeconds(str string) (int, bool) {
+    for _, layout := range []string{"15:04", "15:04:05"} {
+        if t, err := time.Parse(layout, str); err == nil {
+            hour, minute, second := t.Clock()
+            return hour*3600 + minute*60 + second, true
+        }
+    }
+    return 0, false
+}
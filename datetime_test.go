@@ -0,0 +1,114 @@
+package validator
+
+import (
+    "testing"
+    "time"
+)
+
+func TestISO8601Duration(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"PT0S", true},
+        {"P3W", true},
+        {"P1DT2H", true},
+        {"P1Y2M3DT4H5M6S", true},
+        {"P1M", true},
+        {"PT1M", true},
+        {"PT1.5S", true},
+        {"PT1,5S", true},
+        {"P0.5D", true},
+        {"P1.5W", true},
+
+        {"", false},
+        {"P", false},
+        {"PT", false},
+        {"P1DT", false},
+        {"T1H", false},
+        {"1D", false},
+        {"P1W2D", false},
+        {"P2DT1W", false},
+        {"PT1W", false},
+        {"P1D2Y", false},
+        {"PT1H2H", false},
+        {"P1.5DT2H", false},
+        {"PT1.5H30M", false},
+        {"P-1D", false},
+        {"pt1h", false},
+        {"P1DT2H ", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Interval").ISO8601Duration()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("ISO8601Duration(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+
+    v := New()
+    v.Field("P", "Interval").ISO8601Duration()
+    if got, want := firstError(v), "Interval must be a valid ISO 8601 duration"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
+
+func TestRFC3339(t *testing.T) {
+    tests := []struct {
+        value       string
+        valid, nano bool
+    }{
+        {"2024-02-29T13:45:00Z", true, true},
+        {"2024-02-29T13:45:00+01:00", true, true},
+        {"2024-02-29T13:45:00.123Z", false, true},
+        {"2024-02-29T13:45:00", false, false},
+        {"2024-02-29 13:45:00Z", false, false},
+        {"2023-02-29T13:45:00Z", false, false},
+        {"2024-02-29", false, false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "CreatedAt").RFC3339()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("RFC3339(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+
+        v = New()
+        v.Field(tt.value, "CreatedAt").RFC3339Nano()
+        if valid := v.Validate(false) == nil; valid != tt.nano {
+            t.Errorf("RFC3339Nano(%q): valid = %v, want %v", tt.value, valid, tt.nano)
+        }
+    }
+}
+
+func TestISODurationValue(t *testing.T) {
+    tests := []struct {
+        value string
+        want  time.Duration
+        ok    bool
+    }{
+        {"PT0S", 0, true},
+        {"PT2H", 2 * time.Hour, true},
+        {"PT1H30M", 90 * time.Minute, true},
+        {"PT1.5S", 1500 * time.Millisecond, true},
+        {"PT0,5H", 30 * time.Minute, true},
+        {"P1D", 24 * time.Hour, true},
+        {"P1DT2H", 26 * time.Hour, true},
+        {"P3W", 21 * 24 * time.Hour, true},
+        {"P1M", 0, false},
+        {"P1Y", 0, false},
+        {"PT1M", time.Minute, true},
+        {"P", 0, false},
+        {"1h30m", 0, false},
+        {"PT9999999999999H", 0, false},
+    }
+
+    for _, tt := range tests {
+        got, ok := isoDurationValue(tt.value)
+        if got != tt.want || ok != tt.ok {
+            t.Errorf("isoDurationValue(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+        }
+    }
+}
@@ -0,0 +1,359 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// decimalPattern matches plain decimal numbers: an optional sign, digits,
+// and an optional fraction with at least one digit. Scientific notation
+// is deliberately not matched.
+var decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// DecimalString validates that the value is a string holding a plain
+// decimal number, such as "19.99", "-3" or "+0.5". Leading and trailing
+// spaces, a missing integer part (".5"), a trailing dot ("5."), thousands
+// separators, and scientific notation ("1e3") are all rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DecimalString()
+//    f.DecimalString("Price must be a number like 19.99")
+func (f *Field) DecimalString(messages ...string) *Field {
+    f.addRule("decimalString", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !decimalPattern.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a decimal number", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// MaxDecimals validates that a number has at most `places` digits after
+// the decimal point, e.g. MaxDecimals(2) accepts "19.99" and "19" but
+// rejects "19.999".
+//
+// Strings (and json.Number) are the primary input: the digits are counted
+// exactly as typed, so trailing zeros count ("19.990" has 3 places). They
+// must be plain decimals as accepted by DecimalString; scientific notation
+// is rejected rather than normalized. Float values are checked using their
+// shortest exact representation, which cannot always reflect what the user
+// typed, so prefer strings for money. Integer kinds always pass.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxDecimals(2)
+//    f.MaxDecimals(2, "Price can have at most 2 decimal places")
+func (f *Field) MaxDecimals(places int, messages ...string) *Field {
+    f.addRule("maxDecimals", []interface{}{places}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        count, ok := decimalPlaces(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a decimal number", f.name)
+        }
+
+        if count > places {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must have at most %d decimal places", f.name, places)
+        }
+        return nil
+    })
+    return f
+}
+
+// decimalPlaces counts the digits after the decimal point of a decimal
+// string, json.Number, or number.
+func decimalPlaces(value interface{}) (int, bool) {
+    var str string
+    switch v := value.(type) {
+    case string:
+        str = v
+    case json.Number:
+        str = string(v)
+    default:
+        if isInteger(value) && !isFloat(value) {
+            return 0, true
+        }
+        n, ok := toFloat64(value)
+        if !ok || math.IsInf(n, 0) {
+            return 0, false
+        }
+
+        bits := 64
+        if reflect.ValueOf(value).Kind() == reflect.Float32 {
+            bits = 32
+        }
+        str = strconv.FormatFloat(n, 'f', -1, bits)
+    }
+
+    if !decimalPattern.MatchString(str) {
+        return 0, false
+    }
+    if _, fraction, found := strings.Cut(str, "."); found {
+        return len(fraction), true
+    }
+    return 0, true
+}
+
+// IntStringBetween validates that the value is a string holding a whole
+// number between `min` and `max` inclusive, such as a "limit" or "page"
+// query parameter, in one rule: "Limit must be a number between 1 and 100"
+// is reported whether the string doesn't parse or is out of range.
+// Surrounding spaces are rejected, and numbers too large for an int64,
+// such as a 30 digit one, are out of range rather than wrapped.
+// A min above max is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IntStringBetween(1, 100)
+//    f.IntStringBetween(1, 100, "Limit must be between 1 and 100")
+func (f *Field) IntStringBetween(min, max int, messages ...string) *Field {
+    f.addRule("intStringBetween", []interface{}{min, max}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok {
+            n, err := strconv.ParseInt(str, 10, 64)
+            ok = err == nil && n >= int64(min) && n <= int64(max)
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a number between %d and %d", f.name, min, max)
+        }
+        return nil
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// FloatStringBetween validates that the value is a string holding a plain
+// decimal number, as accepted by DecimalString, between `min` and `max`
+// inclusive. The same message is reported whether the string doesn't
+// parse or is out of range.
+// A min above max is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.FloatStringBetween(0.5, 10)
+func (f *Field) FloatStringBetween(min, max float64, messages ...string) *Field {
+    f.addRule("floatStringBetween", []interface{}{min, max}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok && decimalPattern.MatchString(str) {
+            n, err := strconv.ParseFloat(str, 64)
+            ok = err == nil && n >= min && n <= max
+        } else {
+            ok = false
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a number between %v and %v", f.name, min, max)
+        }
+        return nil
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// Int64String validates that the value is a string (or json.Number)
+// holding a whole number that fits in an int64, such as an ID, and converts the value to an int64
+// for the rules after it, on 32-bit builds too. A number out of range is
+// reported as "Account ID is too large" (or too small) rather than as a
+// syntax error, and is never truncated.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Int64String()
+//    f.Int64String().Int64Between(1, 1<<53)
+func (f *Field) Int64String(messages ...string) *Field {
+    f.addRule("int64String", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := numericText(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a number")
+        }
+
+        n, err := strconv.ParseInt(str, 10, 64)
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return parseIntError(f, str, err)
+        }
+
+        f.value = n
+        return nil
+    })
+    return f
+}
+
+// Uint64String validates that the value is a string (or json.Number)
+// holding a whole number from 0 to math.MaxUint64, and converts the value to a uint64 for the
+// rules after it. Out of range numbers are reported as "is too large" or
+// "cannot be negative", and are never truncated.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Uint64String()
+func (f *Field) Uint64String(messages ...string) *Field {
+    f.addRule("uint64String", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := numericText(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a number")
+        }
+
+        n, err := strconv.ParseUint(str, 10, 64)
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            if digits, negative := strings.CutPrefix(str, "-"); negative {
+                if _, err := strconv.ParseUint(digits, 10, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+                    return fmt.Errorf("%s cannot be negative", f.name)
+                }
+            }
+            return parseIntError(f, str, err)
+        }
+
+        f.value = n
+        return nil
+    })
+    return f
+}
+
+// numericText returns a string or json.Number value as a string.
+func numericText(value interface{}) (string, bool) {
+    if number, ok := value.(json.Number); ok {
+        return string(number), true
+    }
+    str, ok := value.(string)
+    return str, ok
+}
+
+// parseIntError returns the error for a string that strconv failed to
+// parse as a whole number, telling out of range numbers from others.
+func parseIntError(f *Field, str string, err error) error {
+    if !errors.Is(err, strconv.ErrRange) {
+        return fmt.Errorf("%s must be a number", f.name)
+    }
+    if strings.HasPrefix(str, "-") {
+        return fmt.Errorf("%s is too small", f.name)
+    }
+    return fmt.Errorf("%s is too large", f.name)
+}
+
+// Int64Between validates that the value is a whole number between `min`
+// and `max` inclusive, compared exactly as int64 values. The value may be
+// an integer of any kind, such as the int64 left by Int64String, a float
+// without a fraction, or a string or json.Number, which is parsed like
+// Int64String does.
+// A min above max is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Int64Between(1, math.MaxInt64)
+//    f.Int64String().Int64Between(1000, 9999, "PIN must have 4 digits")
+func (f *Field) Int64Between(min, max int64, messages ...string) *Field {
+    f.addRule("int64Between", []interface{}{min, max}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, err := int64Value(f)
+        if err == nil && (n < min || n > max) {
+            err = fmt.Errorf("%s must be between %d and %d", f.name, min, max)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// int64Value returns the value of f as an int64, or the error to report
+// when it is not a whole number in the int64 range.
+func int64Value(f *Field) (int64, error) {
+    if str, ok := numericText(f.value); ok {
+        n, err := strconv.ParseInt(str, 10, 64)
+        if err != nil {
+            return 0, parseIntError(f, str, err)
+        }
+        return n, nil
+    }
+
+    rv := reflect.ValueOf(f.value)
+    switch rv.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return rv.Int(), nil
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        if rv.Uint() > math.MaxInt64 {
+            return 0, fmt.Errorf("%s is too large", f.name)
+        }
+        return int64(rv.Uint()), nil
+    }
+
+    // Floats such as the float64 of decoded JSON must be whole numbers.
+    n, ok := toFloat64(f.value)
+    switch {
+    case !ok || !isInteger(f.value):
+        return 0, mismatch(f, "a whole number")
+    case n >= math.MaxInt64:
+        return 0, fmt.Errorf("%s is too large", f.name)
+    case n < math.MinInt64:
+        return 0, fmt.Errorf("%s is too small", f.name)
+    }
+    return int64(n), nil
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+    "encoding/json"
+    "math"
+    "strconv"
+    "testing"
+)
+
+func TestInt64String(t *testing.T) {
+    maxInt64 := strconv.FormatInt(math.MaxInt64, 10)
+    minInt64 := strconv.FormatInt(math.MinInt64, 10)
+
+    tests := []struct {
+        value   interface{}
+        want    int64
+        message string
+    }{
+        {"42", 42, ""},
+        {maxInt64, math.MaxInt64, ""},
+        {minInt64, math.MinInt64, ""},
+        {json.Number(maxInt64), math.MaxInt64, ""},
+        {"9223372036854775808", 0, "Account ID is too large"},
+        {"-9223372036854775809", 0, "Account ID is too small"},
+        {"1234567890123456789012345", 0, "Account ID is too large"},
+        {"12.5", 0, "Account ID must be a number"},
+        {"1e3", 0, "Account ID must be a number"},
+        {"", 0, "Account ID must be a number"},
+        {42, 0, "Account ID must be a number"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        f := v.Field(tt.value, "Account ID").Int64String()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("Int64String(%#v): got %q, want %q", tt.value, got, tt.message)
+            continue
+        }
+        if tt.message == "" && f.Value() != tt.want {
+            t.Errorf("Int64String(%#v): Value() = %#v, want %d", tt.value, f.Value(), tt.want)
+        }
+    }
+}
+
+func TestUint64String(t *testing.T) {
+    tests := []struct {
+        value   string
+        message string
+    }{
+        {"9223372036854775808", ""},
+        {"18446744073709551615", ""},
+        {"18446744073709551616", "Account ID is too large"},
+        {"1234567890123456789012345", "Account ID is too large"},
+        {"-1", "Account ID cannot be negative"},
+        {"-1234567890123456789012345", "Account ID cannot be negative"},
+        {"abc", "Account ID must be a number"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Account ID").Uint64String()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("Uint64String(%q): got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestInt64Between(t *testing.T) {
+    tests := []struct {
+        value    interface{}
+        min, max int64
+        message  string
+    }{
+        {"9223372036854775807", 1, math.MaxInt64, ""},
+        {int64(math.MaxInt64), 1, math.MaxInt64, ""},
+        {"9223372036854775808", 1, math.MaxInt64, "Account ID is too large"},
+        {uint64(math.MaxInt64) + 1, 1, math.MaxInt64, "Account ID is too large"},
+        {"1234567890123456789012345", 1, math.MaxInt64, "Account ID is too large"},
+        {"9007199254740993", 1, 1 << 53, "Account ID must be between 1 and 9007199254740992"},
+        {"9007199254740992", 1, 1 << 53, ""},
+        {"0", 1, 10, "Account ID must be between 1 and 10"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Account ID").Int64Between(tt.min, tt.max)
+        if got := firstError(v); got != tt.message {
+            t.Errorf("Int64Between(%d, %d) of %#v: got %q, want %q", tt.min, tt.max, tt.value, got, tt.message)
+        }
+    }
+
+    // The int64 left by Int64String is compared exactly.
+    v := New()
+    v.Field("9223372036854775806", "Account ID").Int64String().Int64Between(1, math.MaxInt64-2)
+    if got, want := firstError(v), "Account ID must be between 1 and 9223372036854775805"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    v = New()
+    v.Field("5", "Account ID").Int64Between(10, 1)
+    if v.Err() == nil {
+        t.Error("Int64Between(10, 1) is not a configuration error")
+    }
+}
@@ -0,0 +1,145 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+)
+
+// DecimalMin validates that the value is a plain decimal string (or
+// json.Number), as accepted by DecimalString, of at least `min`. The
+// comparison is exact, digit by digit, without converting to float64, so
+// "0.30000000000000000001" is above "0.3", and "0.1" equals "0.10".
+// Scientific notation ("1e2") is rejected, not normalized. A `min` that
+// is not a plain decimal is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DecimalMin("0.01")
+//    f.DecimalMin("0.01", "Amount must be at least 1 cent")
+func (f *Field) DecimalMin(min string, messages ...string) *Field {
+    f.addRule("decimalMin", []interface{}{min}, func(f *Field) error {
+        return checkDecimalRange(f, min, "", messages, fmt.Sprintf("must be at least %s", min))
+    })
+    return f.misconfigured(decimalBoundsError(min, ""))
+}
+
+// DecimalMax validates that the value is a plain decimal string (or
+// json.Number) of at most `max`, compared exactly like DecimalMin.
+// A `max` that is not a plain decimal is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DecimalMax("999999999999.99")
+func (f *Field) DecimalMax(max string, messages ...string) *Field {
+    f.addRule("decimalMax", []interface{}{max}, func(f *Field) error {
+        return checkDecimalRange(f, "", max, messages, fmt.Sprintf("must be at most %s", max))
+    })
+    return f.misconfigured(decimalBoundsError("", max))
+}
+
+// DecimalBetween validates that the value is a plain decimal string (or
+// json.Number) between `min` and `max` inclusive, compared exactly like
+// DecimalMin. Bounds that are not plain decimals, or a min above max, are
+// a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DecimalBetween("0.01", "10000.00")
+func (f *Field) DecimalBetween(min, max string, messages ...string) *Field {
+    f.addRule("decimalBetween", []interface{}{min, max}, func(f *Field) error {
+        return checkDecimalRange(f, min, max, messages, fmt.Sprintf("must be between %s and %s", min, max))
+    })
+    return f.misconfigured(decimalBoundsError(min, max))
+}
+
+// checkDecimalRange checks the value of f against the bounds that are not
+// empty, reporting `problem` when it is out of range.
+func checkDecimalRange(f *Field, min, max string, messages []string, problem string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, ok := numericText(f.value)
+    if !ok || !decimalPattern.MatchString(str) {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s must be a decimal number", f.name)
+    }
+
+    if (min != "" && compareDecimal(str, min) < 0) || (max != "" && compareDecimal(str, max) > 0) {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s %s", f.name, problem)
+    }
+    return nil
+}
+
+// decimalBoundsError returns the configuration error of DecimalMin,
+// DecimalMax or DecimalBetween, whose unused bound is "".
+func decimalBoundsError(min, max string) error {
+    for _, bound := range []string{min, max} {
+        if bound != "" && !decimalPattern.MatchString(bound) {
+            return fmt.Errorf("bound %q is not a plain decimal number", bound)
+        }
+    }
+    if min != "" && max != "" && compareDecimal(min, max) > 0 {
+        return fmt.Errorf("minimum %s is greater than maximum %s", min, max)
+    }
+    return nil
+}
+
+// compareDecimal compares two strings matching decimalPattern exactly,
+// returning -1, 0 or 1 like strings.Compare. Trailing zeros of the
+// fraction, leading zeros, and the sign of zero do not matter.
+func compareDecimal(a, b string) int {
+    aNegative, aInt, aFrac := splitDecimal(a)
+    bNegative, bInt, bFrac := splitDecimal(b)
+
+    if aNegative != bNegative {
+        if aNegative {
+            return -1
+        }
+        return 1
+    }
+
+    cmp := len(aInt) - len(bInt)
+    if cmp == 0 {
+        cmp = strings.Compare(aInt, bInt)
+    }
+    if cmp == 0 {
+        // Padding the shorter fraction with zeros makes the strings
+        // compare like the numbers.
+        width := len(aFrac)
+        if len(bFrac) > width {
+            width = len(bFrac)
+        }
+        cmp = strings.Compare(aFrac+strings.Repeat("0", width-len(aFrac)), bFrac+strings.Repeat("0", width-len(bFrac)))
+    }
+
+    switch {
+    case cmp == 0:
+        return 0
+    case (cmp < 0) != aNegative:
+        return -1
+    default:
+        return 1
+    }
+}
+
+// splitDecimal splits a string matching decimalPattern into its sign and
+// its integer and fraction digits, without leading or trailing zeros. Zero
+// is never negative.
+func splitDecimal(str string) (negative bool, integer, fraction string) {
+    negative = strings.HasPrefix(str, "-")
+    str = strings.TrimLeft(str, "+-")
+    integer, fraction, _ = strings.Cut(str, ".")
+    integer = strings.TrimLeft(integer, "0")
+    fraction = strings.TrimRight(fraction, "0")
+    if integer == "" && fraction == "" {
+        negative = false
+    }
+    return negative, integer, fraction
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+    "encoding/json"
+    "testing"
+)
+
+func TestCompareDecimal(t *testing.T) {
+    tests := []struct {
+        a, b string
+        want int
+    }{
+        {"0.1", "0.10", 0},
+        {"00.1", "0.1000", 0},
+        {"-0", "0", 0},
+        {"-0.00", "+0", 0},
+        {"0.30000000000000000001", "0.3", 1},
+        {"12345678901234567890.5", "12345678901234567890.49999999999999999999", 1},
+        {"99999999999999999999999", "100000000000000000000000", -1},
+        {"-1", "1", -1},
+        {"-2", "-1", -1},
+        {"-0.10000000000000000001", "-0.1", -1},
+        {"1", "0.9", 1},
+    }
+
+    for _, tt := range tests {
+        if got := compareDecimal(tt.a, tt.b); got != tt.want {
+            t.Errorf("compareDecimal(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+        }
+        if got := compareDecimal(tt.b, tt.a); got != -tt.want {
+            t.Errorf("compareDecimal(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+        }
+    }
+}
+
+func TestDecimalRange(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        rule    func(f *Field)
+        message string
+    }{
+        {"0.10", func(f *Field) { f.DecimalMin("0.1") }, ""},
+        {"0.10", func(f *Field) { f.DecimalMax("0.1") }, ""},
+        {"0.30000000000000000001", func(f *Field) { f.DecimalMax("0.3") }, "Amount must be at most 0.3"},
+        {"0.29999999999999999999", func(f *Field) { f.DecimalMin("0.3") }, "Amount must be at least 0.3"},
+        {"0.29999999999999999999", func(f *Field) { f.DecimalMax("0.3") }, ""},
+        {"1e2", func(f *Field) { f.DecimalMin("0") }, "Amount must be a decimal number"},
+        {"-5", func(f *Field) { f.DecimalMin("-10") }, ""},
+        {"-10.01", func(f *Field) { f.DecimalMin("-10") }, "Amount must be at least -10"},
+        {"+3", func(f *Field) { f.DecimalBetween("-3", "3") }, ""},
+        {"-3.000000000000000000001", func(f *Field) { f.DecimalBetween("-3", "3") }, "Amount must be between -3 and 3"},
+        {json.Number("10000.00"), func(f *Field) { f.DecimalBetween("0.01", "10000.00") }, ""},
+        {json.Number("10000.001"), func(f *Field) { f.DecimalBetween("0.01", "10000.00") }, "Amount must be between 0.01 and 10000.00"},
+        {"0", func(f *Field) { f.DecimalMin("0.01", "Amount must be at least 1 cent") }, "Amount must be at least 1 cent"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        tt.rule(v.Field(tt.value, "Amount"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%v: got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestDecimalRangeBounds(t *testing.T) {
+    rules := map[string]func(f *Field){
+        "DecimalMin(1e2)":        func(f *Field) { f.DecimalMin("1e2") },
+        "DecimalMax(abc)":        func(f *Field) { f.DecimalMax("abc") },
+        "DecimalBetween(10, 1)":  func(f *Field) { f.DecimalBetween("10", "1") },
+        "DecimalBetween(0.1, x)": func(f *Field) { f.DecimalBetween("0.1", "x") },
+    }
+
+    for name, rule := range rules {
+        v := New()
+        rule(v.Field("5", "Amount"))
+        if v.Err() == nil {
+            t.Errorf("%s is not a configuration error", name)
+        }
+    }
+}
@@ -0,0 +1,400 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+    "time"
+)
+
+// Describe lists, per field, a human readable description of every rule
+// the field will check, for generating API documentation, such as
+// {"Email": {"required", "must be a valid email", "at most 254 characters"}}.
+// Fields are keyed like ValidationError.Field; optional fields start with
+// "optional", rules added after Each are prefixed with "each item: " and
+// warning rules with "warning: ". Nothing is validated. Rules added by
+// Custom are described as "must pass a custom check" unless Explain gives
+// them a description.
+//
+// Example:
+//
+//    for field, rules := range v.Describe() {
+//        fmt.Printf("%s: %s\n", field, strings.Join(rules, ", "))
+//    }
+func (v *Validator) Describe() map[string][]string {
+    return describeFields(v.root().fields)
+}
+
+// Describe lists, per field key, a description of every rule declared on
+// the schema. See Validator.Describe.
+func (s *Schema) Describe() map[string][]string {
+    return describeFields(s.fields)
+}
+
+// Explain sets the description that Describe reports for the most recently
+// added rule, typically a Custom rule. Without a rule on the field it does
+// nothing.
+//
+// Example:
+//    f.Custom(checkPromoCode).Explain("must be an active promo code")
+func (f *Field) Explain(text string) *Field {
+    f.updateLastRule(func(r *rule) {
+        r.description = text
+    })
+    return f
+}
+
+// describeFields builds the Describe map for fields.
+func describeFields(fields []*Field) map[string][]string {
+    described := make(map[string][]string, len(fields))
+    for _, f := range fields {
+        key := f.fieldKey()
+        lines := described[key]
+        if f.optional && len(lines) == 0 {
+            lines = append(lines, "optional")
+        }
+        for _, r := range f.rules {
+            text := r.describe()
+            if r.each {
+                text = "each item: " + text
+            }
+            if r.warning {
+                text = "warning: " + text
+            }
+            lines = append(lines, text)
+        }
+        described[key] = lines
+    }
+    return described
+}
+
+// describe returns the description of r, as set by Explain or derived
+// from its name and parameters.
+func (r *rule) describe() string {
+    if r.description != "" {
+        return r.description
+    }
+
+    param := func(i int) interface{} {
+        if i < len(r.params) {
+            return r.params[i]
+        }
+        return nil
+    }
+    formatTime := func(i int) string {
+        t, _ := param(i).(time.Time)
+        return t.Format(time.RFC3339)
+    }
+    quoted := func(i int) string {
+        list, _ := param(i).([]string)
+        parts := make([]string, len(list))
+        for j, item := range list {
+            parts[j] = fmt.Sprintf("%q", item)
+        }
+        return strings.Join(parts, ", ")
+    }
+
+    switch r.name {
+    case "required", "notZeroTime", "requiredNonBlank":
+        return "required"
+    case "present":
+        return "must be present"
+    case "string":
+        return "must be a string"
+    case "number":
+        return "must be a number"
+    case "integer":
+        return "must be an integer"
+    case "integerString":
+        return "must be a whole number written as text"
+    case "bool":
+        return "must be a boolean"
+    case "email":
+        return "must be a valid email"
+    case "phone":
+        return "must be a valid phone number"
+    case "url":
+        return "must be a valid URL"
+    case "uuid":
+        return "must be a valid UUID"
+    case "date":
+        return "must be a date (YYYY-MM-DD)"
+    case "min", "gte":
+        return fmt.Sprintf("at least %v", param(0))
+    case "max", "lte":
+        return fmt.Sprintf("at most %v", param(0))
+    case "gt":
+        return fmt.Sprintf("more than %v", param(0))
+    case "lt":
+        return fmt.Sprintf("less than %v", param(0))
+    case "len":
+        return fmt.Sprintf("exactly %v long", param(0))
+    case "eq":
+        return fmt.Sprintf("must be equal to %v", param(0))
+    case "ne":
+        return fmt.Sprintf("must not be equal to %v", param(0))
+    case "minLength":
+        return fmt.Sprintf("at least %v characters", param(0))
+    case "maxLength":
+        return fmt.Sprintf("at most %v characters", param(0))
+    case "minItems":
+        return fmt.Sprintf("at least %v items", param(0))
+    case "maxItems":
+        return fmt.Sprintf("at most %v items", param(0))
+    case "matches":
+        return fmt.Sprintf("must match %v", param(0))
+    case "matchesExtract":
+        return fmt.Sprintf("must match %v in full", param(0))
+    case "oneOf":
+        return "must be one of " + joinValues(r.params)
+    case "enum":
+        values, _ := registeredEnum(fmt.Sprint(param(0)))
+        return "must be one of " + strings.Join(values, ", ")
+    case "custom":
+        return "must pass a custom check"
+    case "computed":
+        return "must pass a computed check"
+    case "minRunes":
+        return fmt.Sprintf("at least %v characters", param(0))
+    case "maxRunes":
+        return fmt.Sprintf("at most %v characters", param(0))
+    case "minGraphemes":
+        return fmt.Sprintf("must be at least %v characters long, as displayed", param(0))
+    case "maxGraphemes":
+        return fmt.Sprintf("must be at most %v characters long, as displayed", param(0))
+    case "timeWindow":
+        return "must be a time or a duration of a time window"
+    case "uniqueBy":
+        return "must be unique"
+    case "existsBy":
+        return "must exist"
+    case "decimalString":
+        return "must be a decimal number written as text"
+    case "maxDecimals":
+        return fmt.Sprintf("at most %v decimal places", param(0))
+    case "currencyCode":
+        return "must be an ISO 4217 currency code"
+    case "currencyPair":
+        return fmt.Sprintf("two different ISO 4217 currency codes joined by %q", param(0))
+    case "ticker":
+        return "must be a ticker symbol"
+    case "amount":
+        return "must be a valid amount"
+    case "color":
+        return "must be a CSS color"
+    case "noHTML":
+        return "must not contain HTML"
+    case "noScriptTags":
+        return "must not contain scripts or event handlers"
+    case "notContainsAny":
+        return "must not contain banned words"
+    case "noBidiControl":
+        return "must not contain directional formatting characters"
+    case "noInvisibleChars":
+        return "must not contain invisible characters"
+    case "notSimilarTo":
+        return fmt.Sprintf("must not be similar to another value (similarity above %v)", param(0))
+    case "notSimilarToField":
+        return fmt.Sprintf("must not be similar to %v (similarity above %v)", param(0), param(1))
+    case "noEmoji":
+        return "must not contain emoji"
+    case "maxEmoji":
+        return fmt.Sprintf("at most %v emoji", param(0))
+    case "normalize":
+        return fmt.Sprintf("normalized to %v", param(0))
+    case "username":
+        return "must be a valid username"
+    case "arn":
+        return "must be a valid ARN"
+    case "s3BucketName":
+        return "must be a valid S3 bucket name"
+    case "dns1123Label":
+        return "must be an RFC 1123 label"
+    case "dns1123Subdomain":
+        return "must be an RFC 1123 subdomain"
+    case "k8sLabelValue":
+        return "must be a valid Kubernetes label value"
+    case "imageRef":
+        return "must be a container image reference"
+    case "gitSHA":
+        return "must be a full commit SHA"
+    case "gitAbbrevSHA":
+        return "must be a commit SHA of at least 7 characters"
+    case "gitRefName":
+        return "must be a valid git ref name"
+    case "identifierCase":
+        return fmt.Sprintf("must be in %v", param(0))
+    case "hostPort":
+        return "must be a host and port, such as localhost:6379"
+    case "semver":
+        return "must be a semantic version"
+    case "semverConstraint":
+        return fmt.Sprintf("must be a semantic version satisfying %v", param(0))
+    case "signatureHeader":
+        return "must be a webhook signature such as sha256=<hex digest>"
+    case "publicIP":
+        return "must be a public IP address"
+    case "privateIP":
+        return "must be a private IP address"
+    case "publicURL":
+        return "must be a public URL"
+    case "dataURI":
+        return "must be a data URI"
+    case "rfc3339", "rfc3339Nano":
+        return "must be an RFC 3339 timestamp"
+    case "iso8601Duration":
+        return "must be an ISO 8601 duration"
+    case "btcAddress":
+        return "must be a valid Bitcoin address"
+    case "ethAddress":
+        return "must be a valid Ethereum address"
+    case "ssn":
+        return "must be a valid Social Security number"
+    case "nin":
+        return "must be a valid NIN"
+    case "bvn":
+        return "must be a valid BVN"
+    case "nationalID":
+        return fmt.Sprintf("must be a valid national ID of %v", param(0))
+    case "vat":
+        if country, _ := param(0).(string); country != "" {
+            return fmt.Sprintf("must be a valid %s VAT number", country)
+        }
+        return "must be a valid VAT number"
+    case "int64String":
+        return "a whole number that fits in 64 bits, written as text"
+    case "uint64String":
+        return "a non-negative whole number that fits in 64 bits, written as text"
+    case "even":
+        return "an even whole number"
+    case "odd":
+        return "an odd whole number"
+    case "signedCompatible":
+        return fmt.Sprintf("a whole number that fits in a signed %v-bit integer", param(0))
+    case "int64Between":
+        return fmt.Sprintf("a whole number between %v and %v", param(0), param(1))
+    case "intStringBetween", "floatStringBetween":
+        return fmt.Sprintf("a number between %v and %v", param(0), param(1))
+    case "decimalMin":
+        return fmt.Sprintf("a decimal number of at least %v", param(0))
+    case "decimalMax":
+        return fmt.Sprintf("a decimal number of at most %v", param(0))
+    case "decimalBetween":
+        return fmt.Sprintf("a decimal number between %v and %v", param(0), param(1))
+    case "minTime":
+        return "not before " + formatTime(0)
+    case "maxTime":
+        return "not after " + formatTime(0)
+    case "timeBetween":
+        return fmt.Sprintf("between %s and %s", formatTime(0), formatTime(1))
+    case "shellSafe":
+        if allowed, _ := param(0).(string); allowed != "" {
+            return fmt.Sprintf("only letters, digits and %q", allowed)
+        }
+        return "only letters and digits"
+    case "charset":
+        return fmt.Sprintf("only the characters %v", param(0))
+    case "charsetIn":
+        return "only allowed characters"
+    case "excludeChars":
+        return fmt.Sprintf("none of the characters %v", param(0))
+    case "eachStruct":
+        return "must be a list"
+    case "noSQLWildcards":
+        return `must not contain "%" or "_"`
+    case "escapeSQLWildcards":
+        return "LIKE wildcards are escaped"
+    case "pageNumber":
+        return "a whole number of at least 1"
+    case "perPage":
+        return fmt.Sprintf("a whole number between %v and %v", param(0), param(1))
+    case "sortField":
+        if list, _ := param(0).([]string); len(list) == 0 {
+            return "must be empty"
+        }
+        return "one of " + quoted(0) + `, optionally prefixed with "-"`
+    case "weekday":
+        if opts, _ := param(0).(CalendarOption); opts&CalendarAllowNumbers != 0 {
+            return "a day of the week, by name or 1 (Monday) to 7 (Sunday)"
+        }
+        return "a day of the week"
+    case "month":
+        if opts, _ := param(0).(CalendarOption); opts&CalendarAllowNumbers != 0 {
+            return "a month, by name or 1 to 12"
+        }
+        return "a month"
+    case "percent", "percentString":
+        return fmt.Sprintf("a percentage between %v and %v", param(0), param(1))
+    case "basisPoints":
+        return "whole basis points between 0 and 10000"
+    case "withinHours":
+        return fmt.Sprintf("between %v and %v (%v)", param(0), param(1), param(2))
+    case "maxFileSize":
+        size, _ := param(0).(int64)
+        return "at most " + formatBytes(size)
+    case "minFileSize":
+        size, _ := param(0).(int64)
+        return "at least " + formatBytes(size)
+    case "imageDimensions":
+        return fmt.Sprintf("a PNG, JPEG, GIF or WebP image between %vx%v and %vx%v pixels", param(0), param(1), param(2), param(3))
+    case "gtField":
+        return fmt.Sprintf("greater than %v", param(0))
+    case "gteField":
+        return fmt.Sprintf("greater than or equal to %v", param(0))
+    case "ltField":
+        return fmt.Sprintf("less than %v", param(0))
+    case "lteField":
+        return fmt.Sprintf("less than or equal to %v", param(0))
+    case "asString":
+        return "must be text"
+    case "imei":
+        return "must be a valid IMEI"
+    case "imeisv":
+        return "must be a valid IMEISV"
+    case "meid":
+        return "must be a valid MEID"
+    case "bboxCorner":
+        return fmt.Sprintf("a number between -%v and %v", param(0), param(0))
+    case "lngLatPair":
+        return "must be a [longitude, latitude] pair"
+    case "linearRing":
+        return "must be a closed ring of at least 4 [longitude, latitude] pairs"
+    case "byteSize":
+        return "a size such as 512KB or 1.5GiB"
+    case "byteSizeBetween":
+        min, _ := param(0).(int64)
+        max, _ := param(1).(int64)
+        return fmt.Sprintf("between %s and %s", formatByteSize(min), formatByteSize(max))
+    case "phoneForRegion":
+        return fmt.Sprintf("must be a valid %v phone number", param(0))
+    case "trim":
+        return "surrounding white space is trimmed"
+    case "single":
+        return "exactly one value"
+    case "emailList":
+        return "must be email addresses separated by commas or semicolons"
+    case "maxRepeatedRun":
+        return fmt.Sprintf("no character repeated more than %v times in a row", param(0))
+    case "minDistinctChars":
+        return fmt.Sprintf("at least %v different characters", param(0))
+    case "containerCode":
+        return "must be an ISO 6346 container code"
+    case "imoNumber":
+        return "must be an IMO ship number"
+    case "countryCode":
+        return "must be an ISO 3166-1 country code"
+    case "postalCode":
+        if param(0) == "" {
+            return "must be a valid postal code"
+        }
+        return fmt.Sprintf("must be a valid %v postal code", param(0))
+    case "region":
+        return fmt.Sprintf("must be a region of %v", param(0))
+    case "domain":
+        return "must be a valid domain name"
+    case "subset":
+        return "must only contain " + quoted(0)
+    case "containsAll":
+        return "must include " + quoted(0)
+    }
+    return r.name
+}
@@ -0,0 +1,75 @@
+package validator
+
+import (
+    "regexp"
+    "strings"
+)
+
+var (
+    imeiPattern   = regexp.MustCompile(`^\d{15}$`)
+    imeisvPattern = regexp.MustCompile(`^\d{16}$`)
+    meidPattern   = regexp.MustCompile(`^[0-9A-Fa-f]{14}$`)
+)
+
+// deviceIDSeparators removes the spaces and hyphens allowed between the
+// groups of a device identifier.
+var deviceIDSeparators = strings.NewReplacer(" ", "", "-", "")
+
+// IMEI validates that the value is a 15-digit IMEI whose last digit is the
+// Luhn check digit, such as "490154203237518". Spaces and hyphens between
+// digits are ignored, so "49-015420-323751-8" passes too. The error does
+// not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IMEI()
+//    f.IMEI("Enter the 15-digit IMEI shown by dialing *#06#")
+func (f *Field) IMEI(messages ...string) *Field {
+    f.addRule("imei", nil, func(f *Field) error {
+        return checkNationalID(f, messages, isIMEI, "must be a valid IMEI")
+    })
+    return f
+}
+
+// IMEISV validates that the value is a 16-digit IMEISV, the IMEI variant
+// carrying a software version instead of a check digit. Spaces and hyphens
+// between digits are ignored. The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IMEISV()
+func (f *Field) IMEISV(messages ...string) *Field {
+    f.addRule("imeisv", nil, func(f *Field) error {
+        return checkNationalID(f, messages, func(str string) bool {
+            return imeisvPattern.MatchString(deviceIDSeparators.Replace(str))
+        }, "must be a valid IMEISV")
+    })
+    return f
+}
+
+// MEID validates that the value is a MEID, the identifier of CDMA devices:
+// 14 hexadecimal characters in either case, such as "A0000000002329".
+// Spaces and hyphens are ignored. The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MEID()
+func (f *Field) MEID(messages ...string) *Field {
+    f.addRule("meid", nil, func(f *Field) error {
+        return checkNationalID(f, messages, isMEID, "must be a valid MEID")
+    })
+    return f
+}
+
+// isIMEI reports whether str is 15 digits passing the Luhn check,
+// ignoring separators.
+func isIMEI(str string) bool {
+    str = deviceIDSeparators.Replace(str)
+    return imeiPattern.MatchString(str) && luhnValid(str)
+}
+
+// isMEID reports whether str is 14 hexadecimal characters, ignoring
+// separators.
+func isMEID(str string) bool {
+    return meidPattern.MatchString(deviceIDSeparators.Replace(str))
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestIMEI(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"490154203237518", true},
+        {"49-015420-323751-8", true},
+        {"49 015420 323751 8", true},
+        {"490154203237517", false},
+        {"49015420323751", false},
+        {"4901542032375180", false},
+        {"49015420323751A", false},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, func(f *Field) { f.IMEI() }); valid != tt.valid {
+            t.Errorf("IMEI(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestIMEIErrorHidesNumber(t *testing.T) {
+    v := New()
+    v.Field("490154203237517", "IMEI").IMEI()
+    if msg := firstError(v); msg == "" || strings.Contains(msg, "490154203237517") {
+        t.Errorf("error %q repeats the number", msg)
+    }
+}
+
+func TestIMEISVAndMEID(t *testing.T) {
+    tests := []struct {
+        rule  func(f *Field)
+        value string
+        valid bool
+    }{
+        {func(f *Field) { f.IMEISV() }, "3566910202165301", true},
+        {func(f *Field) { f.IMEISV() }, "35-669102-021653-01", true},
+        {func(f *Field) { f.IMEISV() }, "356691020216530", false},
+        {func(f *Field) { f.MEID() }, "A0000000002329", true},
+        {func(f *Field) { f.MEID() }, "a0 000000 002329", true},
+        {func(f *Field) { f.MEID() }, "A000000000232G", false},
+        {func(f *Field) { f.MEID() }, "A000000000232", false},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, tt.rule); valid != tt.valid {
+            t.Errorf("%q: valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+    "fmt"
+    "reflect"
+)
+
+// Each makes the rules added after it on this field check every item of a
+// list value, such as the []string of a repeated form key, instead of the
+// list itself. Errors name the item, as in "Tags[1] cannot be longer than
+// 20 characters", and are reported item by item. Rules added before Each,
+// such as MinItems, still check the whole list. A value that is not a
+// slice or an array fails with a single error; nil has no items.
+//
+// Rules that rewrite the value, such as Trim, only affect the rules after
+// them on the same item; the list returned by Value is not changed.
+//
+// Example:
+//    s.List("tags", "Tags").
+//        MinItems(1).
+//        MaxItems(5).
+//        Each().
+//        Required().
+//        MaxLength(20)
+func (f *Field) Each() *Field {
+    f.each = true
+    return f
+}
+
+// Single validates that a list value, such as the []string of a form key,
+// holds exactly one item, and replaces the list with that item for the
+// rules after it, for parameters that must not be repeated. Values that are
+// not lists are left unchanged.
+// Accepts an optional custom error message.
+//
+// Example:
+//    b.Field(r.Form["page"], "Page").Single().IntegerString().Min(1)
+func (f *Field) Single(messages ...string) *Field {
+    f.addRule("single", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        rv := reflect.ValueOf(f.value)
+        if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+            return nil
+        }
+        if rv.Len() != 1 {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must have exactly one value, got %d", f.name, rv.Len())
+        }
+
+        f.value = rv.Index(0).Interface()
+        return nil
+    })
+    return f
+}
+
+// item returns a copy of f holding item `index` of its list, named and
+// keyed with the index, for checking the rules added after Each.
+func (f *Field) item(index int, value interface{}) *Field {
+    item := *f
+    item.raw = value
+    item.value = value
+    item.name = fmt.Sprintf("%s[%d]", f.name, index)
+    item.key = fmt.Sprintf("%s[%d]", f.fieldKey(), index)
+    return &item
+}
+
+// EachStruct registers the fields of every element of the list `items`,
+// such as the []LineItem of an order, by calling `build` with the index,
+// the element and a scoped validator, like Scope, named after the element:
+// errors read "Items[2].SKU is required". A nil element, such as in a
+// slice of pointers, is reported as "Items[1] is required" without calling
+// build. A value that is not a slice or an array fails with a single
+// error, and nil has no elements.
+//
+// Example:
+//
+//    v.EachStruct(order.Items, "Items", func(i int, item interface{}, sv *validator.Validator) {
+//        line := item.(*LineItem)
+//        sv.Field(line.SKU, "SKU").Required()
+//        sv.Field(line.Quantity, "Quantity").Integer().Min(1)
+//    })
+func (v *Validator) EachStruct(items interface{}, name string, build func(i int, item interface{}, sv *Validator)) *Validator {
+    list, ok := sliceValue(items)
+    if !ok {
+        if items != nil {
+            f := v.Field(items, name)
+            f.addRule("eachStruct", nil, func(f *Field) error {
+                return fmt.Errorf("%s must be a list", f.name)
+            })
+        }
+        return v
+    }
+
+    for i := 0; i < list.Len(); i++ {
+        itemName := fmt.Sprintf("%s[%d]", name, i)
+        elem := list.Index(i)
+        switch elem.Kind() {
+        case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+            if elem.IsNil() {
+                v.Field(nil, itemName).Required()
+                continue
+            }
+        }
+        build(i, elem.Interface(), v.scoped(itemName))
+    }
+    return v
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestEach(t *testing.T) {
+    tests := []struct {
+        value interface{}
+        want  map[string][]string
+    }{
+        {nil, nil},
+        {[]string{}, nil},
+        {[]string{"go"}, nil},
+        {[]string{"golang"}, map[string][]string{"Tags[0]": {"Tags[0] cannot be longer than 5 characters"}}},
+        {[]string{"go", "golang", "", "rust"}, map[string][]string{
+            "Tags[1]": {"Tags[1] cannot be longer than 5 characters"},
+            "Tags[2]": {"Tags[2] is required"},
+        }},
+        {[2]string{"go", "kotlin"}, map[string][]string{"Tags[1]": {"Tags[1] cannot be longer than 5 characters"}}},
+        {"go", map[string][]string{"Tags": {"Tags must be a list"}}},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Tags").Each().Required().MaxRunes(5)
+        if got := v.ValidateN(0).ErrorsMap(); !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("Each(%#v): got %q, want %q", tt.value, got, tt.want)
+        }
+    }
+
+    // Rules before Each check the whole list.
+    v := New()
+    v.Field([]string{"a", "b", "c"}, "Tags").MaxItems(2).Each().Required()
+    if got, want := firstError(v), "Tags cannot contain more than 2 items"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
+
+func TestSingle(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        message string
+    }{
+        {[]string{}, "Page must have exactly one value, got 0"},
+        {[]string{"2"}, ""},
+        {[]string{"0"}, "0 cannot be less than 1"},
+        {[]string{"2", "3"}, "Page must have exactly one value, got 2"},
+        {"2", ""},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Page").Single().IntegerString().Min(1)
+        if got := firstError(v); got != tt.message {
+            t.Errorf("Single(%#v): got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+
+    v := New()
+    f := v.Field([]string{"7"}, "Page").Single()
+    v.Validate(false)
+    if f.Value() != "7" {
+        t.Errorf("Value() = %#v, want the single item", f.Value())
+    }
+}
+
+func TestEachStruct(t *testing.T) {
+    type lineItem struct {
+        SKU      string
+        Quantity int
+    }
+    items := []*lineItem{
+        {SKU: "A-1", Quantity: 1},
+        nil,
+        {SKU: "C-3", Quantity: 0},
+        {SKU: "D-4", Quantity: 2},
+        {SKU: "E-5", Quantity: 5},
+    }
+
+    v := New()
+    v.EachStruct(items, "Items", func(i int, item interface{}, sv *Validator) {
+        line := item.(*lineItem)
+        sv.Field(line.SKU, "SKU").Required()
+        sv.Field(line.Quantity, "Quantity").Integer().Min(1, "Quantity must be at least 1")
+    })
+
+    want := map[string][]string{
+        "Items[1]":          {"Items[1] is required"},
+        "Items[2].Quantity": {"Quantity must be at least 1"},
+    }
+    if got := v.ValidateN(0).ErrorsMap(); !reflect.DeepEqual(got, want) {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    // Messages name the element.
+    v = New()
+    v.EachStruct([]lineItem{{}, {SKU: "B-2", Quantity: 1}}, "Items", func(i int, item interface{}, sv *Validator) {
+        sv.Field(item.(lineItem).SKU, "SKU").Required()
+    })
+    if got, want := firstError(v), "Items[0].SKU is required"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    v = New()
+    v.EachStruct("not a list", "Items", func(i int, item interface{}, sv *Validator) {})
+    if got, want := firstError(v), "Items must be a list"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    v = New()
+    v.EachStruct(nil, "Items", func(i int, item interface{}, sv *Validator) {
+        t.Error("build called for a nil list")
+    })
+    if errs := v.Validate(false); errs != nil {
+        t.Errorf("nil list: %v", errs)
+    }
+}
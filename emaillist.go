@@ -0,0 +1,86 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+)
+
+// EmailListOpts configures EmailListWith.
+type EmailListOpts struct {
+    // Max is the largest number of addresses accepted. 0 means no limit.
+    Max int
+    // RejectEmpty reports empty entries, such as the one left by a
+    // trailing comma in "a@example.com,", instead of ignoring them.
+    RejectEmpty bool
+    // Email relaxes the check of each address, as for EmailWith.
+    Email EmailOption
+}
+
+// EmailList validates that the value is a string of email addresses
+// separated by commas or semicolons, such as a "CC" field: every address,
+// trimmed of surrounding white space, must pass Email. The error gives the
+// position of the first invalid entry, counting from 1. Empty entries are
+// ignored. The value becomes the []string of addresses, for the rules after
+// it and for Value, so the caller doesn't split it again.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.EmailList() // "ann@example.com; bob@example.com" becomes []string{"ann@example.com", "bob@example.com"}
+//    // "a@example.com, b@example.com, nope": Recipients: entry 3 is not a valid email
+func (f *Field) EmailList(messages ...string) *Field {
+    return f.EmailListWith(EmailListOpts{}, messages...)
+}
+
+// EmailListWith is EmailList with a limit on the number of addresses, or
+// with empty entries rejected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.EmailListWith(validator.EmailListOpts{Max: 10, RejectEmpty: true})
+func (f *Field) EmailListWith(opts EmailListOpts, messages ...string) *Field {
+    f.addRule("emailList", []interface{}{opts}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var addresses []string
+        var err error
+        if !ok {
+            err = fmt.Errorf("%s must be a list of emails separated by commas", f.name)
+        }
+        var entries []string
+        if strings.TrimSpace(str) != "" {
+            entries = strings.Split(strings.ReplaceAll(str, ";", ","), ",")
+        }
+        for i, entry := range entries {
+            if err != nil {
+                break
+            }
+            entry = strings.TrimSpace(entry)
+            switch {
+            case entry == "" && opts.RejectEmpty:
+                err = fmt.Errorf("%s: entry %d is empty", f.name, i+1)
+            case entry == "":
+            case !isEmailWith(entry, opts.Email):
+                err = fmt.Errorf("%s: entry %d is not a valid email", f.name, i+1)
+            default:
+                addresses = append(addresses, entry)
+            }
+        }
+        if err == nil && opts.Max > 0 && len(addresses) > opts.Max {
+            err = fmt.Errorf("%s can have at most %d addresses", f.name, opts.Max)
+        }
+
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return err
+        }
+        f.value = addresses
+        return nil
+    })
+    return f
+}
@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"fmt"
+)
+
+// NoEmoji validates that a string contains no emoji, including
+// ZWJ sequences, flags, keycaps and emoji with skin-tone modifiers.
+// Accented letters such as "é" and ordinary punctuation are not emoji.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NoEmoji()
+//    f.NoEmoji("Username cannot contain emoji")
+func (f *Field) NoEmoji(messages ...string) *Field {
+    f.addRule("noEmoji", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || countEmoji(str) > 0 {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain emoji", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// MaxEmoji validates that a string contains at most `count` emoji.
+// A sequence displayed as a single emoji, such as a family joined with
+// ZWJ, a flag, or a thumbs up with a skin tone, counts once.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxEmoji(3)
+//    f.MaxEmoji(3, "Display name can have at most 3 emoji")
+func (f *Field) MaxEmoji(count int, messages ...string) *Field {
+    f.addRule("maxEmoji", []interface{}{count}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || countEmoji(str) > count {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain more than %d emoji", f.name, count)
+        }
+        return nil
+    })
+    return f
+}
+
+// Code points used to build emoji sequences.
+const (
+    zeroWidthJoiner   = '\u200d'
+    variationSelector = '\ufe0f'
+    combiningKeycap   = '\u20e3'
+)
+
+// countEmoji counts the emoji in str, treating each sequence that is
+// displayed as one emoji as a single emoji.
+func countEmoji(str string) int {
+    runes := []rune(str)
+    count := 0
+
+    for i := 0; i < len(runes); i++ {
+        r := runes[i]
+
+        // Keycaps: a digit, # or * followed by an optional
+        // variation selector and the combining keycap.
+        if r == '#' || r == '*' || (r >= '0' && r <= '9') {
+            j := i + 1
+            if j < len(runes) && runes[j] == variationSelector {
+                j++
+            }
+            if j < len(runes) && runes[j] == combiningKeycap {
+                count++
+                i = j
+            }
+            continue
+        }
+
+        // Flags: a pair of regional indicators.
+        if isRegionalIndicator(r) {
+            count++
+            if i+1 < len(runes) && isRegionalIndicator(runes[i+1]) {
+                i++
+            }
+            continue
+        }
+
+        if !isEmojiRune(r) {
+            continue
+        }
+
+        count++
+        // Consume modifiers and ZWJ-joined emoji that belong to this one.
+        for i+1 < len(runes) {
+            if isEmojiModifier(runes[i+1]) {
+                i++
+            } else if runes[i+1] == zeroWidthJoiner && i+2 < len(runes) && isEmojiRune(runes[i+2]) {
+                i += 2
+            } else {
+                break
+            }
+        }
+    }
+    return count
+}
+
+// isEmojiRune reports whether r is a pictographic emoji or symbol that
+// is displayed as emoji.
+func isEmojiRune(r rune) bool {
+    switch {
+    case r >= 0x1F000 && r <= 0x1FAFF:
+        return !isEmojiModifier(r) && !isRegionalIndicator(r)
+    case r >= 0x2600 && r <= 0x27BF:
+        return true
+    case r == 0x231A, r == 0x231B, r == 0x2328, r == 0x23CF,
+        r >= 0x23E9 && r <= 0x23F3, r >= 0x23F8 && r <= 0x23FA:
+        return true
+    case r >= 0x2B05 && r <= 0x2B07, r == 0x2B1B, r == 0x2B1C, r == 0x2B50, r == 0x2B55:
+        return true
+    case r == 0x2934, r == 0x2935, r == 0x3030, r == 0x303D, r == 0x3297, r == 0x3299:
+        return true
+    }
+    return false
+}
+
+// isEmojiModifier reports whether r modifies the preceding emoji:
+// a skin tone, the emoji variation selector, or a tag character.
+func isEmojiModifier(r rune) bool {
+    return (r >= 0x1F3FB && r <= 0x1F3FF) || r == variationSelector || (r >= 0xE0020 && r <= 0xE007F)
+}
+
+// isRegionalIndicator reports whether r is one of the letters used in pairs to form flags.
+func isRegionalIndicator(r rune) bool {
+    return r >= 0x1F1E6 && r <= 0x1F1FF
+}
@@ -0,0 +1,67 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestCountEmoji(t *testing.T) {
+    tests := []struct {
+        str  string
+        want int
+    }{
+        {"👨‍👩‍👧", 1},
+        {"🇳🇬", 1},
+        {"🇳🇬🇬🇭", 2},
+        {"👍🏽", 1},
+        {"1️⃣", 1},
+        {"é", 0},
+        {"é", 0},
+        {"café, naïve!", 0},
+        {"Ada 👍🏽 🇳🇬 👨‍👩‍👧", 3},
+        {"", 0},
+    }
+
+    for _, tt := range tests {
+        if got := countEmoji(tt.str); got != tt.want {
+            t.Errorf("countEmoji(%q) = %d, want %d", tt.str, got, tt.want)
+        }
+    }
+}
+
+func TestNoEmoji(t *testing.T) {
+    tests := []struct {
+        value interface{}
+        valid bool
+    }{
+        {"ada_lovelace", true},
+        {"José", true},
+        {"ada👨‍👩‍👧", false},
+        {"ada🇳🇬", false},
+        {"ada👍🏽", false},
+        {42, false},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, func(f *Field) { f.NoEmoji() }); valid != tt.valid {
+            t.Errorf("NoEmoji(%v): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestMaxEmoji(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"Ada", true},
+        {"Ada 👨‍👩‍👧🇳🇬", true},
+        {"Ada 👨‍👩‍👧🇳🇬👍🏽", false},
+        {"Renée 🇫🇷", true},
+    }
+
+    for _, tt := range tests {
+        if valid := passes(tt.value, func(f *Field) { f.MaxEmoji(2) }); valid != tt.valid {
+            t.Errorf("MaxEmoji(2) of %q: valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
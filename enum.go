@@ -0,0 +1,110 @@
+package validator
+
+import (
+    "fmt"
+    "reflect"
+    "strings"
+    "sync"
+)
+
+// enumSet is the set of values registered for an enum, or the reason it
+// cannot be used.
+type enumSet struct {
+    values []string
+    err    error
+}
+
+var (
+    enumsMu sync.RWMutex
+    enums   = map[string]enumSet{}
+)
+
+// RegisterEnum registers the values of a Go enum, such as the typed
+// string constants of `type Status string`, under `name`, for the Enum
+// rule, so the API accepts a new constant as soon as it is added to the
+// list next to its declaration. It is safe for concurrent use and
+// typically called once at startup, before the rules using it are
+// declared.
+//
+// Registering a name twice, or with no values, returns an error, and the
+// Enum rules using that name are then a *ConfigError (see Validator.Err).
+//
+// Example:
+//
+//    type Status string
+//
+//    const (
+//        StatusOpen   Status = "open"
+//        StatusClosed Status = "closed"
+//    )
+//
+//    func init() {
+//        validator.RegisterEnum("status", StatusOpen, StatusClosed)
+//    }
+func RegisterEnum[T ~string](name string, values ...T) error {
+    enumsMu.Lock()
+    defer enumsMu.Unlock()
+
+    set := enumSet{}
+    for _, value := range values {
+        set.values = append(set.values, string(value))
+    }
+    if existing, ok := enums[name]; ok {
+        set = existing
+        set.err = fmt.Errorf("enum %q is registered more than once", name)
+    } else if len(values) == 0 {
+        set.err = fmt.Errorf("enum %q is registered without values", name)
+    }
+
+    enums[name] = set
+    return set.err
+}
+
+// registeredEnum returns the values of the enum `name`.
+func registeredEnum(name string) ([]string, error) {
+    enumsMu.RLock()
+    defer enumsMu.RUnlock()
+
+    set, ok := enums[name]
+    if !ok {
+        return nil, fmt.Errorf("enum %q is not registered", name)
+    }
+    return set.values, set.err
+}
+
+// Enum validates that the value is one of the values registered with
+// RegisterEnum under `name`. Both plain strings, as decoded from JSON, and
+// values of the enum type itself, or any other string type, are accepted,
+// and compared case-sensitively. The error lists the allowed values. An
+// enum that is not registered, or was registered twice or without values,
+// is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Enum("status")
+//    // Status must be one of open, closed
+func (f *Field) Enum(name string, messages ...string) *Field {
+    values, err := registeredEnum(name)
+
+    f.addRule("enum", []interface{}{name}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        rv := reflect.ValueOf(f.value)
+        if rv.Kind() == reflect.String {
+            for _, allowed := range values {
+                if rv.String() == allowed {
+                    return nil
+                }
+            }
+        }
+
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s must be one of %s", f.name, strings.Join(values, ", "))
+    })
+    return f.misconfigured(err)
+}
@@ -0,0 +1,163 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// ValidationError describes a single problem with the input.
+// Every error returned by Validate for a failing rule is a ValidationError,
+// so callers can use errors.As to get the field and rule, while Error()
+// returns the same message as before.
+type ValidationError struct {
+    // Field is the key of the field in its input, such as the form key
+    // or JSON path, or its name for fields registered with Field().
+    Field string
+    // Rule is the name of the rule that failed, such as "minLength".
+    Rule string
+    // Message is the human readable message.
+    Message string
+    // Code is the machine readable code set with WithCode, or empty.
+    Code string
+    // Param is the parameter the rule was registered with, such as 8 for
+    // MinLength(8), a slice when it has several, or nil.
+    Param interface{}
+    // Value is the value that failed the rule. It is only set when the
+    // validator captures values (see Validator.CaptureValues), so secrets
+    // don't end up in logs by accident.
+    Value interface{}
+    // Err is the error returned by the rule, if any.
+    Err error
+    // Received is the Go type of the value, such as "float64" or "nil",
+    // when a rule such as Integer or String rejected it for its type. It
+    // is only set in debug mode (see Validator.Debug).
+    Received string
+}
+
+// Error returns the human readable message.
+func (e ValidationError) Error() string {
+    return e.Message
+}
+
+// LogValue implements slog.LogValuer, so logging a ValidationError with
+// log/slog records its field, rule, parameter, code and message, and the
+// value when it was captured.
+//
+// Example:
+//
+//    logger.Warn("invalid request", "error", verr)
+//    // error.field=Password error.rule=minLength error.param=8 error.message="..."
+func (e ValidationError) LogValue() slog.Value {
+    attrs := []slog.Attr{
+        slog.String("field", e.Field),
+        slog.String("rule", e.Rule),
+    }
+    if e.Param != nil {
+        attrs = append(attrs, slog.Any("param", e.Param))
+    }
+    if e.Code != "" {
+        attrs = append(attrs, slog.String("code", e.Code))
+    }
+    if e.Value != nil {
+        attrs = append(attrs, slog.Any("value", e.Value))
+    }
+    if e.Received != "" {
+        attrs = append(attrs, slog.String("received", e.Received))
+    }
+    attrs = append(attrs, slog.String("message", e.Message))
+    return slog.GroupValue(attrs...)
+}
+
+// Unwrap returns the error returned by the rule.
+func (e ValidationError) Unwrap() error {
+    return e.Err
+}
+
+// newValidationError wraps an error returned by a rule of f.
+// Errors that already are ValidationErrors are returned unchanged.
+func newValidationError(f *Field, rule string, err error) error {
+    if _, ok := err.(ValidationError); ok {
+        return err
+    }
+    return ValidationError{
+        Field:   f.fieldKey(),
+        Rule:    rule,
+        Message: err.Error(),
+        Err:     err,
+    }
+}
+
+// ruleError wraps an error returned by rule r of f, applying the message
+// and code set with WithMessage and WithCode and recording the rule's
+// parameters and, if v captures values, the failing value. In debug mode
+// the type received by a rule that rejected it is added. The value of a
+// Sensitive field is redacted.
+func (v *Validator) ruleError(f *Field, r *rule, err error) error {
+    verr, ok := newValidationError(f, r.name, err).(ValidationError)
+    if !ok {
+        return err
+    }
+    if r.message != "" {
+        verr.Message = r.message
+    }
+    if r.code != "" {
+        verr.Code = r.code
+    }
+
+    switch len(r.params) {
+    case 0:
+    case 1:
+        verr.Param = r.params[0]
+    default:
+        verr.Param = append([]interface{}(nil), r.params...)
+    }
+    if v.captureValues {
+        verr.Value = f.value
+    }
+    var typeErr *typeError
+    if v.debug && errors.As(err, &typeErr) {
+        verr.Received = typeErr.received
+        if r.message == "" {
+            verr.Message += fmt.Sprintf(" (received %s)", typeErr.received)
+        }
+    }
+    if f.sensitive {
+        verr = f.redact(verr)
+    }
+    return verr
+}
+
+// typeError is returned by rules that reject a value because of its type,
+// so debug mode can report the type that was received.
+type typeError struct {
+    message  string
+    received string
+}
+
+// Error returns the message without the received type.
+func (e *typeError) Error() string {
+    return e.message
+}
+
+// mismatch returns the error of a rule of f that expects `expected`, such
+// as "an integer", and got a value of another type.
+func mismatch(f *Field, expected string) error {
+    received := "nil"
+    if f.value != nil {
+        received = fmt.Sprintf("%T", f.value)
+    }
+    return &typeError{message: fmt.Sprintf("%s must be %s", f.name, expected), received: received}
+}
+
+// PanicError is wrapped by the ValidationError reported when a rule panics.
+// Stack is only captured when the validator is in debug mode.
+type PanicError struct {
+    Value interface{}
+    Stack []byte
+}
+
+// Error describes the recovered panic value.
+func (e *PanicError) Error() string {
+    return fmt.Sprintf("panic: %v", e.Value)
+}
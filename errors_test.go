@@ -0,0 +1,127 @@
+package validator
+
+import (
+    "bytes"
+    "errors"
+    "log/slog"
+    "runtime"
+    "testing"
+)
+
+func TestPanickingRuleIsRecovered(t *testing.T) {
+    for _, debug := range []bool{false, true} {
+        v := New().Debug(debug)
+        v.Field("ada", "Name").Custom(func(value interface{}) error {
+            _ = value.(int)
+            return nil
+        })
+        v.Field("", "Email").Required()
+
+        errs := v.Validate(false)
+        if len(errs) != 2 {
+            t.Fatalf("got errors %v, want the panic and Email is required", errs)
+        }
+
+        var verr ValidationError
+        if !errors.As(errs[0], &verr) || verr.Field != "Name" || verr.Rule != "custom" {
+            t.Fatalf("got %#v, want a ValidationError of the Name custom rule", errs[0])
+        }
+        var panicErr *PanicError
+        if !errors.As(errs[0], &panicErr) {
+            t.Fatalf("%v does not wrap a *PanicError", errs[0])
+        }
+        if _, ok := panicErr.Value.(runtime.Error); !ok {
+            t.Errorf("PanicError.Value = %#v, want the failed type assertion", panicErr.Value)
+        }
+        if hasStack := panicErr.Stack != nil; hasStack != debug {
+            t.Errorf("debug %v: stack captured: %v", debug, hasStack)
+        }
+    }
+}
+
+func TestRecoverPanicsDisabled(t *testing.T) {
+    v := New().RecoverPanics(false)
+    v.Field("ada", "Name").Custom(func(value interface{}) error {
+        panic("broken rule")
+    })
+
+    defer func() {
+        if recovered := recover(); recovered != "broken rule" {
+            t.Errorf("recovered %v, want the panic of the rule", recovered)
+        }
+    }()
+    v.Validate(false)
+    t.Error("Validate returned instead of panicking")
+}
+
+func TestValidationErrorLogValue(t *testing.T) {
+    tests := []struct {
+        capture bool
+        want    string
+    }{
+        {false, `level=WARN msg="invalid request" error.field=Username error.rule=minRunes error.param=8 error.code=too_short error.message="Username must be at least 8 characters long"` + "\n"},
+        {true, `level=WARN msg="invalid request" error.field=Username error.rule=minRunes error.param=8 error.code=too_short error.value=ada error.message="Username must be at least 8 characters long"` + "\n"},
+    }
+
+    for _, tt := range tests {
+        v := New().CaptureValues(tt.capture)
+        v.Field("ada", "Username").MinRunes(8).WithCode("too_short")
+        errs := v.Validate(false)
+        if len(errs) != 1 {
+            t.Fatalf("got errors %v", errs)
+        }
+
+        var out bytes.Buffer
+        logger := slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{
+            ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+                if a.Key == slog.TimeKey && len(groups) == 0 {
+                    return slog.Attr{}
+                }
+                return a
+            },
+        }))
+        logger.Warn("invalid request", "error", errs[0])
+
+        if out.String() != tt.want {
+            t.Errorf("CaptureValues(%v):\n got %s\nwant %s", tt.capture, out.String(), tt.want)
+        }
+    }
+}
+
+func TestReceivedInDebugMode(t *testing.T) {
+    tests := []struct {
+        value    interface{}
+        received string
+    }{
+        {30.5, "float64"},
+        {"30", "string"},
+        {nil, "nil"},
+        {int64(30), ""},
+    }
+
+    for _, tt := range tests {
+        for _, debug := range []bool{false, true} {
+            v := New().Debug(debug)
+            v.Field(tt.value, "Age").Integer()
+            errs := v.Validate(false)
+
+            if tt.received == "" {
+                if errs != nil {
+                    t.Errorf("Integer(%#v): unexpected errors %v", tt.value, errs)
+                }
+                continue
+            }
+
+            verr := errs[0].(ValidationError)
+            message, received := "Age must be an integer", ""
+            if debug {
+                message += " (received " + tt.received + ")"
+                received = tt.received
+            }
+            if verr.Message != message || verr.Received != received {
+                t.Errorf("Integer(%#v), debug %v: got %q and %q, want %q and %q",
+                    tt.value, debug, verr.Message, verr.Received, message, received)
+            }
+        }
+    }
+}
@@ -0,0 +1,47 @@
+package validator_test
+
+import (
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "strings"
+
+    validator "github.com/Olumuyiwaray/go-validator"
+)
+
+// signup validates a signup form, answering 422 with the errors of each
+// field when the input is invalid.
+func signup(w http.ResponseWriter, r *http.Request) {
+    b := validator.FromRequest(r)
+    b.Form("email", "Email").Required().Email()
+    b.Query("page", "Page").IntegerString().Min(1)
+    b.Header("X-Api-Key", "API Key").Required()
+
+    if err := b.Err(); err != nil {
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    if errs := b.Validate(false); errs != nil {
+        w.Header().Set("Content-Type", "application/json")
+        w.WriteHeader(http.StatusUnprocessableEntity)
+        json.NewEncoder(w).Encode(map[string]interface{}{"errors": validator.ErrorsMap(errs)})
+        return
+    }
+    w.WriteHeader(http.StatusNoContent)
+}
+
+func ExampleFromRequest() {
+    form := url.Values{"email": {"ada@example"}}
+    r := httptest.NewRequest(http.MethodPost, "/signup?page=0", strings.NewReader(form.Encode()))
+    r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+    w := httptest.NewRecorder()
+    signup(w, r)
+    fmt.Println(w.Code)
+    fmt.Print(w.Body.String())
+    // Output:
+    // 422
+    // {"errors":{"X-Api-Key":["API Key is required"],"email":["Email must be a valid email"],"page":["0 cannot be less than 1"]}}
+}
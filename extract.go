@@ -0,0 +1,71 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+)
+
+// MatchesExtract validates that the whole string value matches the regular
+// expression `pattern` and, when it does, stores the text of each named
+// group, such as (?P<year>\d{4}), in `into` under the group's name. Groups
+// that didn't take part in the match are stored as "". `into` is left
+// unchanged when the value doesn't match, and may be nil to only validate.
+//
+// The pattern is compiled once, when the rule is registered. An invalid
+// pattern, or one without named groups when `into` is not nil, is a
+// *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    ref := map[string]string{}
+//    v.Field(req.Order, "Order Reference").
+//        MatchesExtract(`ORD-(?P<year>\d{4})-(?P<seq>\d{6})`, ref)
+//    if errs := v.Validate(false); errs == nil {
+//        year, seq := ref["year"], ref["seq"] // "2024", "000123"
+//    }
+func (f *Field) MatchesExtract(pattern string, into map[string]string, messages ...string) *Field {
+    re, compileErr := regexp.Compile(pattern)
+    if compileErr == nil {
+        re = regexp.MustCompile(`^(?:` + pattern + `)$`)
+    }
+    if compileErr == nil && into != nil && !hasNamedGroups(re) {
+        compileErr = fmt.Errorf("pattern %q has no named groups to extract", pattern)
+    }
+
+    f.addRule("matchesExtract", []interface{}{pattern}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var match []string
+        if ok {
+            match = re.FindStringSubmatch(str)
+        }
+        if match == nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s has an invalid format", f.name)
+        }
+
+        for i, name := range re.SubexpNames() {
+            if name != "" && into != nil {
+                into[name] = match[i]
+            }
+        }
+        return nil
+    })
+    return f.misconfigured(compileErr)
+}
+
+// hasNamedGroups reports whether re has at least one named group.
+func hasNamedGroups(re *regexp.Regexp) bool {
+    for _, name := range re.SubexpNames() {
+        if name != "" {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors is a list of validation errors that is itself an error,
+// for returning the result of Validate through an error value.
+// Its Error() lists every message on one line; use FormatErrors
+// for a multi-line listing.
+//
+// Example:
+//
+//    if errs := v.Validate(false); errs != nil {
+//        return validator.Errors(errs)
+//    }
+type Errors []error
+
+// Error lists every message on one line, in registration order:
+// "3 validation errors: Email is required; Age must be a number; ...".
+// A single error is returned as its own message.
+func (e Errors) Error() string {
+    switch len(e) {
+    case 0:
+        return "no validation errors"
+    case 1:
+        return e[0].Error()
+    }
+
+    messages := make([]string, len(e))
+    for i, err := range e {
+        messages[i] = err.Error()
+    }
+    return fmt.Sprintf("%d validation errors: %s", len(e), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the individual errors, so errors.Is and errors.As
+// look at each of them.
+func (e Errors) Unwrap() []error {
+    return e
+}
+
+// FormatOption configures FormatErrors.
+type FormatOption func(*formatConfig)
+
+// formatConfig holds the options of a FormatErrors call.
+type formatConfig struct {
+    numbered bool
+    color    bool
+}
+
+// Numbered makes FormatErrors number the errors instead of using bullets.
+func Numbered() FormatOption {
+    return func(c *formatConfig) {
+        c.numbered = true
+    }
+}
+
+// WithColor makes FormatErrors highlight the output with ANSI colors,
+// for printing to a terminal.
+func WithColor() FormatOption {
+    return func(c *formatConfig) {
+        c.color = true
+    }
+}
+
+// ANSI escape codes used by WithColor.
+const (
+    ansiBold  = "\033[1m"
+    ansiRed   = "\033[31m"
+    ansiReset = "\033[0m"
+)
+
+// FormatErrors lists errors on separate lines for CLI output, keeping the
+// order they were returned in (field registration order), so the output
+// is stable between runs:
+//
+//    2 validation errors:
+//      - Email is required
+//      - Age must be a number
+//
+// It returns an empty string when there are no errors.
+//
+// Example:
+//
+//    fmt.Print(validator.FormatErrors(errs, validator.Numbered()))
+func FormatErrors(errs []error, opts ...FormatOption) string {
+    if len(errs) == 0 {
+        return ""
+    }
+
+    config := &formatConfig{}
+    for _, opt := range opts {
+        opt(config)
+    }
+
+    var b strings.Builder
+    header := "1 validation error:"
+    if len(errs) > 1 {
+        header = fmt.Sprintf("%d validation errors:", len(errs))
+    }
+    if config.color {
+        header = ansiBold + ansiRed + header + ansiReset
+    }
+    b.WriteString(header)
+    b.WriteString("\n")
+
+    for i, err := range errs {
+        marker := "-"
+        if config.numbered {
+            marker = fmt.Sprintf("%d.", i+1)
+        }
+        if config.color {
+            marker = ansiRed + marker + ansiReset
+        }
+        fmt.Fprintf(&b, "  %s %s\n", marker, err.Error())
+    }
+    return b.String()
+}
+
+// ErrorsMap groups the messages of errs by field key (ValidationError.Field),
+// for JSON error responses such as {"email": ["Email is required"]}. Errors
+// of fields registered more than once under the same name are grouped under
+// that one key, and the messages of each key keep their order. Errors that
+// are not ValidationErrors, such as a *LookupError, are listed under "".
+// It returns nil when there are no errors.
+//
+// Example:
+//
+//    if errs := v.Validate(false); errs != nil {
+//        json.NewEncoder(w).Encode(map[string]interface{}{"errors": validator.ErrorsMap(errs)})
+//    }
+func ErrorsMap(errs []error) map[string][]string {
+    if len(errs) == 0 {
+        return nil
+    }
+
+    grouped := make(map[string][]string)
+    for _, err := range errs {
+        key := ""
+        var verr ValidationError
+        if errors.As(err, &verr) {
+            key = verr.Field
+        }
+        grouped[key] = append(grouped[key], err.Error())
+    }
+    return grouped
+}
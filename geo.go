@@ -0,0 +1,114 @@
+package validator
+
+import (
+    "fmt"
+    "reflect"
+)
+
+// LngLatPair validates that the value is a GeoJSON position: a slice of
+// two numbers, longitude first, between -180 and 180, then latitude,
+// between -90 and 90. []float64, [2]float64 and []interface{} decoded
+// from JSON are accepted. Errors name the failing element, such as
+// "Location[1] must be between -90 and 90".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.LngLatPair() // []float64{3.3792, 6.5244}
+func (f *Field) LngLatPair(messages ...string) *Field {
+    f.addRule("lngLatPair", nil, func(f *Field) error {
+        return geoError(f, messages, checkPosition(f.value, f.name))
+    })
+    return f
+}
+
+// LinearRing validates that the value is a GeoJSON linear ring, the
+// boundary of a polygon: a slice of at least 4 positions, each checked
+// like LngLatPair, whose first and last positions are equal. [][]float64
+// and []interface{} decoded from JSON are accepted. Errors name the
+// failing position, such as "Geometry[3][1] must be between -90 and 90".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.LinearRing()
+//    f.LinearRing("Area must be a closed shape")
+func (f *Field) LinearRing(messages ...string) *Field {
+    f.addRule("linearRing", nil, func(f *Field) error {
+        return geoError(f, messages, checkLinearRing(f.value, f.name))
+    })
+    return f
+}
+
+// geoError returns the custom message instead of err when one was given.
+func geoError(f *Field, messages []string, err error) error {
+    if err != nil && len(messages) > 0 {
+        return fmt.Errorf("%s", messages[0])
+    }
+    return err
+}
+
+// checkLinearRing checks that value is a closed ring of at least four
+// positions, naming errors after `path`.
+func checkLinearRing(value interface{}, path string) error {
+    ring, ok := sliceValue(value)
+    if !ok {
+        return fmt.Errorf("%s must be a list of positions", path)
+    }
+    if ring.Len() < 4 {
+        return fmt.Errorf("%s must have at least 4 positions", path)
+    }
+
+    for i := 0; i < ring.Len(); i++ {
+        if err := checkPosition(ring.Index(i).Interface(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+            return err
+        }
+    }
+
+    first, last := ring.Index(0).Interface(), ring.Index(ring.Len()-1).Interface()
+    for i := 0; i < 2; i++ {
+        if !equalValues(sliceElem(first, i), sliceElem(last, i)) {
+            return fmt.Errorf("%s must be closed: its first and last positions must be equal", path)
+        }
+    }
+    return nil
+}
+
+// checkPosition checks that value is a [longitude, latitude] pair, naming
+// errors after `path`.
+func checkPosition(value interface{}, path string) error {
+    pair, ok := sliceValue(value)
+    if !ok || pair.Len() != 2 {
+        return fmt.Errorf("%s must be a [longitude, latitude] pair", path)
+    }
+
+    limits := [2]float64{180, 90}
+    for i, limit := range limits {
+        n, ok := toFloat64(pair.Index(i).Interface())
+        if !ok {
+            return fmt.Errorf("%s[%d] must be a number", path, i)
+        }
+        if n < -limit || n > limit {
+            return fmt.Errorf("%s[%d] must be between %v and %v", path, i, -limit, limit)
+        }
+    }
+    return nil
+}
+
+// sliceValue returns value as a reflect.Value when it is a slice or an
+// array, looking through interfaces.
+func sliceValue(value interface{}) (reflect.Value, bool) {
+    rv := reflect.ValueOf(value)
+    for rv.Kind() == reflect.Interface {
+        rv = rv.Elem()
+    }
+    switch rv.Kind() {
+    case reflect.Slice, reflect.Array:
+        return rv, true
+    }
+    return reflect.Value{}, false
+}
+
+// sliceElem returns element i of a position known to be valid.
+func sliceElem(value interface{}, i int) interface{} {
+    rv, _ := sliceValue(value)
+    return rv.Index(i).Interface()
+}
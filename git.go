@@ -0,0 +1,118 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+var (
+    gitSHAPattern       = regexp.MustCompile(`^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+    gitAbbrevSHAPattern = regexp.MustCompile(`^([0-9a-fA-F]{7,40}|[0-9a-fA-F]{64})$`)
+)
+
+// GitSHA validates that the value is a full git object name: 40 hex
+// digits for SHA-1 repositories or 64 for SHA-256 ones.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.GitSHA()
+//    f.GitSHA("Commit must be a full commit hash")
+func (f *Field) GitSHA(messages ...string) *Field {
+    f.addRule("gitSHA", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !gitSHAPattern.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a full commit SHA", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// GitAbbrevSHA validates that the value is a git object name that may be
+// abbreviated: 7 to 40 hex digits, or a full 64 digit SHA-256 name.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.GitAbbrevSHA() // "3f2a9c1", "3f2a9c1e..."
+func (f *Field) GitAbbrevSHA(messages ...string) *Field {
+    f.addRule("gitAbbrevSHA", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !gitAbbrevSHAPattern.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a commit SHA of at least 7 characters", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// GitRefName validates that the value is a valid branch or tag name under
+// the rules of `git check-ref-format --allow-onelevel`: no "..", "@{", "//",
+// spaces, control characters or any of ~ ^ : ? * [ \, no leading or
+// trailing "/", no trailing ".", no component starting with "." or ending
+// with ".lock", and not "@" alone.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.GitRefName() // "main", "feature/login", "v1.2.0"
+//    f.GitRefName("Branch name is not valid")
+func (f *Field) GitRefName(messages ...string) *Field {
+    f.addRule("gitRefName", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isGitRefName(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid git ref name", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isGitRefName reports whether str passes git's ref name format checks.
+func isGitRefName(str string) bool {
+    if str == "" || str == "@" || strings.HasSuffix(str, ".") {
+        return false
+    }
+    for _, bad := range []string{"..", "@{", `\`} {
+        if strings.Contains(str, bad) {
+            return false
+        }
+    }
+    for _, r := range str {
+        if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[", r) {
+            return false
+        }
+    }
+
+    // Splitting on "/" yields empty components for leading, trailing
+    // and doubled slashes.
+    for _, component := range strings.Split(str, "/") {
+        if component == "" || strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+            return false
+        }
+    }
+    return true
+}
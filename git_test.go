@@ -0,0 +1,89 @@
+package validator
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestGitRefName(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"main", true},
+        {"feature/login", true},
+        {"v1.2.0", true},
+        {"release/2024.01", true},
+        {"fix-@-sign", true},
+        {"a.lock.b", true},
+        {"café", true},
+
+        {"", false},
+        {"@", false},
+        {"feature..login", false},
+        {"main@{1}", false},
+        {"main.lock", false},
+        {"feature/main.lock", false},
+        {"/main", false},
+        {"main/", false},
+        {"feature//login", false},
+        {".main", false},
+        {"feature/.hidden", false},
+        {"main.", false},
+        {"main\x00", false},
+        {"main\tbranch", false},
+        {"main\x7f", false},
+        {"my branch", false},
+        {"main~1", false},
+        {"main^", false},
+        {"refs:heads", false},
+        {"what?", false},
+        {"feature*", false},
+        {"feature[1]", false},
+        {`feature\login`, false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Branch").GitRefName()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("GitRefName(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestGitSHA(t *testing.T) {
+    sha1 := "3f2a9c1e5b7d4a0f8e6c2b1a9d8e7f6a5b4c3d2e"
+    sha256 := strings.Repeat("ab12", 16)
+
+    tests := []struct {
+        value       string
+        full, abbrev bool
+    }{
+        {sha1[:7], false, true},
+        {sha1[:6], false, false},
+        {sha1[:12], false, true},
+        {sha1, true, true},
+        {strings.ToUpper(sha1), true, true},
+        {sha1 + "a", false, false},
+        {sha256, true, true},
+        {sha256[:63], false, false},
+        {sha256 + "a", false, false},
+        {sha1[:39] + "g", false, false},
+        {"", false, false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Commit").GitSHA()
+        if valid := v.Validate(false) == nil; valid != tt.full {
+            t.Errorf("GitSHA(%q): valid = %v, want %v", tt.value, valid, tt.full)
+        }
+
+        v = New()
+        v.Field(tt.value, "Commit").GitAbbrevSHA()
+        if valid := v.Validate(false) == nil; valid != tt.abbrev {
+            t.Errorf("GitAbbrevSHA(%q): valid = %v, want %v", tt.value, valid, tt.abbrev)
+        }
+    }
+}
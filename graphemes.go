@@ -0,0 +1,194 @@
+package validator
+
+import (
+    "fmt"
+    "unicode"
+)
+
+// MinGraphemes validates that the string value has at least `n`
+// characters as users see them: extended grapheme clusters, as described
+// in Unicode UAX #29. "👍🏽" (a thumbs up and a skin tone), "👨‍👩‍👧" (a family
+// joined with zero width joiners), the flag "🇳🇬" and "é" written as "e"
+// and a combining accent are one character each, while they are 2 to 5
+// runes and 3 to 18 bytes. MinLength and MaxLength count bytes, which
+// suits storage limits, and rune counts, such as utf8.RuneCountInString,
+// count each code point of these sequences; neither matches what users
+// see.
+//
+// The segmentation follows UAX #29 for combining marks, emoji modifiers,
+// zero width joiner sequences, regional indicator pairs, Hangul syllables
+// and CR LF, which covers the text users type. The rare prepended marks of
+// some scripts start a character of their own, and Indic conjuncts count
+// one character per consonant, as before Unicode 15.1. A negative n is a
+// *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinGraphemes(2) // "👍🏽👍🏽" passes, "👍🏽" fails
+func (f *Field) MinGraphemes(n int, messages ...string) *Field {
+    f.addRule("minGraphemes", []interface{}{n}, func(f *Field) error {
+        return checkGraphemes(f, messages, func(count int) bool { return count >= n },
+            fmt.Sprintf("must be at least %d characters long", n))
+    })
+    return f.misconfigured(graphemeLimitError(n))
+}
+
+// MaxGraphemes validates that the string value has at most `n` characters
+// as users see them, counted like MinGraphemes, such as a display name
+// limit defined in visible characters. A negative n is a *ConfigError
+// (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxGraphemes(30)
+//    f.MaxGraphemes(30, "Display name can have at most 30 characters")
+func (f *Field) MaxGraphemes(n int, messages ...string) *Field {
+    f.addRule("maxGraphemes", []interface{}{n}, func(f *Field) error {
+        return checkGraphemes(f, messages, func(count int) bool { return count <= n },
+            fmt.Sprintf("cannot be longer than %d characters", n))
+    })
+    return f.misconfigured(graphemeLimitError(n))
+}
+
+// checkGraphemes checks the grapheme count of the value of f with `ok`,
+// reporting `problem` when it fails.
+func checkGraphemes(f *Field, messages []string, ok func(count int) bool, problem string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, isString := f.value.(string)
+    if !isString {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return mismatch(f, "a string")
+    }
+
+    if !ok(graphemeCount(str)) {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s %s", f.name, problem)
+    }
+    return nil
+}
+
+// graphemeLimitError returns the configuration error of a negative
+// grapheme count, or nil.
+func graphemeLimitError(n int) error {
+    if n < 0 {
+        return fmt.Errorf("count %d is negative", n)
+    }
+    return nil
+}
+
+// graphemeCount counts the extended grapheme clusters of str.
+func graphemeCount(str string) int {
+    count := 0
+    var prev rune
+    // regional counts the regional indicators in a row, so pairs form
+    // one flag; emoji tells whether the cluster so far is an emoji that a
+    // zero width joiner may extend.
+    regional := 0
+    emoji := false
+
+    for i, r := range str {
+        if i > 0 && !graphemeBreak(prev, r, regional, emoji) {
+            if isRegionalIndicator(r) {
+                regional++
+            }
+            if r != zeroWidthJoiner && !isGraphemeExtend(r) {
+                emoji = isEmojiRune(r)
+            }
+            prev = r
+            continue
+        }
+
+        count++
+        regional = 0
+        if isRegionalIndicator(r) {
+            regional = 1
+        }
+        emoji = isEmojiRune(r)
+        prev = r
+    }
+    return count
+}
+
+// graphemeBreak reports whether a new cluster starts at r, after prev.
+func graphemeBreak(prev, r rune, regional int, emoji bool) bool {
+    switch {
+    case prev == '\r' && r == '\n':
+        return false
+    case isGraphemeControl(prev) || isGraphemeControl(r):
+        return true
+    case hangulJoins(prev, r):
+        return false
+    case isGraphemeExtend(r) || r == zeroWidthJoiner:
+        return false
+    case prev == zeroWidthJoiner && emoji && isEmojiRune(r):
+        return false
+    case isRegionalIndicator(prev) && isRegionalIndicator(r):
+        return regional%2 == 0
+    }
+    return true
+}
+
+// isGraphemeControl reports whether r is a control character, which is a
+// cluster of its own.
+func isGraphemeControl(r rune) bool {
+    return r == '\r' || r == '\n' || (unicode.IsControl(r) && r != zeroWidthJoiner)
+}
+
+// isGraphemeExtend reports whether r attaches to the character before it:
+// a combining mark, a variation selector, an emoji skin tone or a tag.
+func isGraphemeExtend(r rune) bool {
+    return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+        (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0100 && r <= 0xE01EF) ||
+        isEmojiModifier(r)
+}
+
+// Hangul jamo kinds, for hangulJoins.
+const (
+    hangulOther = iota
+    hangulL
+    hangulV
+    hangulT
+    hangulLV
+    hangulLVT
+)
+
+// hangulKind returns the Hangul syllable type of r.
+func hangulKind(r rune) int {
+    switch {
+    case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+        return hangulL
+    case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+        return hangulV
+    case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+        return hangulT
+    case r >= 0xAC00 && r <= 0xD7A3:
+        if (r-0xAC00)%28 == 0 {
+            return hangulLV
+        }
+        return hangulLVT
+    }
+    return hangulOther
+}
+
+// hangulJoins reports whether the jamo or syllable r continues the Hangul
+// syllable ending with prev.
+func hangulJoins(prev, r rune) bool {
+    next := hangulKind(r)
+    switch hangulKind(prev) {
+    case hangulL:
+        return next == hangulL || next == hangulV || next == hangulLV || next == hangulLVT
+    case hangulLV, hangulV:
+        return next == hangulV || next == hangulT
+    case hangulLVT, hangulT:
+        return next == hangulT
+    }
+    return false
+}
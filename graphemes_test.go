@@ -0,0 +1,72 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestGraphemeCount(t *testing.T) {
+    tests := []struct {
+        name  string
+        value string
+        want  int
+    }{
+        {"empty", "", 0},
+        {"ascii", "Ada", 3},
+        {"precomposed accent", "café", 4},
+        {"combining accent", "cafe\u0301", 4},
+        {"two combining marks", "e\u0323\u0301", 1},
+        {"skin tone modifier", "\U0001F44D\U0001F3FD", 1},
+        {"two skin tones", "\U0001F44D\U0001F3FD\U0001F44D\U0001F3FF", 2},
+        {"zwj family", "\U0001F468\u200D\U0001F469\u200D\U0001F467", 1},
+        {"zwj family with skin tones", "\U0001F468\U0001F3FB\u200D\U0001F469\U0001F3FD\u200D\U0001F467\U0001F3FF", 1},
+        {"emoji presentation selector", "\u2764\uFE0F", 1},
+        {"flag", "\U0001F1F3\U0001F1EC", 1},
+        {"two flags", "\U0001F1F3\U0001F1EC\U0001F1EC\U0001F1E7", 2},
+        {"flag and a lone indicator", "\U0001F1F3\U0001F1EC\U0001F1EC", 2},
+        {"hangul jamo L V", "\u1100\u1161", 1},
+        {"hangul jamo L V T", "\u1100\u1161\u11A8", 1},
+        {"hangul syllable LV and jamo T", "\uAC00\u11A8", 1},
+        {"hangul syllables", "한국어", 3},
+        {"cr lf", "a\r\nb", 3},
+    }
+
+    for _, tt := range tests {
+        if got := graphemeCount(tt.value); got != tt.want {
+            t.Errorf("%s: graphemeCount(%+q) = %d, want %d", tt.name, tt.value, got, tt.want)
+        }
+    }
+}
+
+func TestGraphemes(t *testing.T) {
+    family := "\U0001F468\u200D\U0001F469\u200D\U0001F467"
+    thumbs := "\U0001F44D\U0001F3FD"
+
+    tests := []struct {
+        value   interface{}
+        rule    func(f *Field)
+        message string
+    }{
+        {thumbs + thumbs, func(f *Field) { f.MinGraphemes(2) }, ""},
+        {thumbs, func(f *Field) { f.MinGraphemes(2) }, "Name must be at least 2 characters long"},
+        {family, func(f *Field) { f.MaxGraphemes(1) }, ""},
+        {family + "a", func(f *Field) { f.MaxGraphemes(1) }, "Name cannot be longer than 1 characters"},
+        {"cafe\u0301", func(f *Field) { f.MaxGraphemes(4) }, ""},
+        {"\U0001F1F3\U0001F1EC", func(f *Field) { f.MaxGraphemes(1) }, ""},
+        {"\u1100\u1161\u11A8", func(f *Field) { f.MaxGraphemes(1) }, ""},
+        {family + "a", func(f *Field) { f.MaxGraphemes(1, "Too long") }, "Too long"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        tt.rule(v.Field(tt.value, "Name"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%+q: got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+
+    v := New()
+    v.Field("Ada", "Name").MinGraphemes(-1)
+    if v.Err() == nil {
+        t.Error("MinGraphemes(-1) is not a configuration error")
+    }
+}
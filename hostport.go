@@ -0,0 +1,123 @@
+package validator
+
+import (
+    "fmt"
+    "net"
+    "net/netip"
+    "strconv"
+    "strings"
+)
+
+// HostPortOpts configures HostPortWith.
+type HostPortOpts struct {
+    // DefaultPort, when it is not 0, accepts an address without a port,
+    // such as "localhost" or "[::1]", and adds this port to it, so Value
+    // returns "localhost:6379". A negative port or one above 65535 is a
+    // *ConfigError.
+    DefaultPort int
+    // IPOnly rejects host names: the host must be an IPv4 address or a
+    // bracketed IPv6 address.
+    IPOnly bool
+}
+
+// HostPort validates that the value is a network address of the form
+// host:port, such as "localhost:6379" or "[::1]:8080", as used for the
+// addresses of databases and caches in configuration. The host may be a
+// host name, including a single label such as "redis", an IPv4 address or
+// an IPv6 address in brackets, and the port a number from 1 to 65535. The
+// error tells whether the host or the port is wrong.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.HostPort() // "localhost:6379", "[::1]:8080"; not "example.com:70000"
+//    // Redis Address has an invalid port "70000", which must be between 1 and 65535
+func (f *Field) HostPort(messages ...string) *Field {
+    return f.HostPortWith(HostPortOpts{}, messages...)
+}
+
+// HostPortWith is HostPort with a default port for addresses without one,
+// or only IP addresses as hosts.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.HostPortWith(validator.HostPortOpts{DefaultPort: 6379}) // "redis" becomes "redis:6379"
+//    f.HostPortWith(validator.HostPortOpts{IPOnly: true})      // "10.0.0.5:53"; not "dns.local:53"
+func (f *Field) HostPortWith(opts HostPortOpts, messages ...string) *Field {
+    f.addRule("hostPort", []interface{}{opts}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var address string
+        var err error
+        if !ok {
+            err = fmt.Errorf("%s must be a host and port, such as localhost:6379", f.name)
+        } else {
+            address, err = checkHostPort(f, str, opts)
+        }
+
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return err
+        }
+        f.value = address
+        return nil
+    })
+    var portErr error
+    if opts.DefaultPort < 0 || opts.DefaultPort > 65535 {
+        portErr = fmt.Errorf("default port %d is not between 1 and 65535", opts.DefaultPort)
+    }
+    return f.misconfigured(portErr)
+}
+
+// checkHostPort checks the address str of f and returns it with the
+// default port of opts added when it has none.
+func checkHostPort(f *Field, str string, opts HostPortOpts) (string, error) {
+    host, port, err := net.SplitHostPort(str)
+    if err != nil {
+        bracketed := strings.HasPrefix(str, "[") && strings.HasSuffix(str, "]")
+        if str == "" || opts.DefaultPort == 0 || (strings.Contains(str, ":") && !bracketed) {
+            if str != "" && strings.Contains(err.Error(), "missing port") {
+                return "", fmt.Errorf("%s must include a port, such as %s:8080", f.name, str)
+            }
+            return "", fmt.Errorf("%s must be a host and port, such as localhost:6379", f.name)
+        }
+        host, port = strings.TrimSuffix(strings.TrimPrefix(str, "["), "]"), strconv.Itoa(opts.DefaultPort)
+    }
+
+    addr, ipErr := netip.ParseAddr(host)
+    switch {
+    case ipErr == nil && addr.Is6() != strings.HasPrefix(str, "["):
+        return "", fmt.Errorf("%s has an invalid host %q", f.name, host)
+    case ipErr != nil && opts.IPOnly:
+        return "", fmt.Errorf("%s must have an IP address as its host, not %q", f.name, host)
+    case ipErr != nil && (!isHostname(host) || strings.HasPrefix(str, "[")):
+        return "", fmt.Errorf("%s has an invalid host %q", f.name, host)
+    }
+
+    if n, err := strconv.Atoi(port); err != nil || strings.Trim(port, "0123456789") != "" || n < 1 || n > 65535 {
+        return "", fmt.Errorf("%s has an invalid port %q, which must be between 1 and 65535", f.name, port)
+    }
+    return net.JoinHostPort(host, port), nil
+}
+
+// isHostname reports whether str is a host name: dot-separated labels of
+// letters, digits and hyphens, not starting or ending with a hyphen, such
+// as "redis" or "db.internal". A name that looks like a number, such as
+// "10.0.0.300", is not a host name.
+func isHostname(str string) bool {
+    str = strings.TrimSuffix(strings.ToLower(str), ".")
+    if str == "" || len(str) > 253 || numericHostPattern.MatchString(str) {
+        return false
+    }
+    for _, label := range strings.Split(str, ".") {
+        if !domainLabelPattern.MatchString(label) {
+            return false
+        }
+    }
+    return true
+}
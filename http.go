@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// defaultMaxMemory is the amount of a multipart form kept in memory,
+// matching the default used by net/http.
+const defaultMaxMemory = 32 << 20
+
+// RequestValidator validates the input of an HTTP request.
+// It embeds a normal Validator, so fields read from the request
+// can be mixed with any other field and checked with Validate().
+//
+// Example:
+//
+//    b := validator.FromRequest(r)
+//    b.Form("email", "Email").Required().Email()
+//    b.Query("page", "Page").IntegerString().Min(1)
+//    b.Header("X-Api-Key", "API Key").Required()
+//
+//    if errs := b.Validate(false); errs != nil {
+//        w.WriteHeader(http.StatusUnprocessableEntity)
+//        ...
+//    }
+type RequestValidator struct {
+    *Validator
+    request  *http.Request
+    parsed   bool
+    parseErr error
+}
+
+// FromRequest creates a RequestValidator reading values from r.
+//
+// Example:
+//
+//    b := validator.FromRequest(r)
+func FromRequest(r *http.Request) *RequestValidator {
+    return &RequestValidator{
+        Validator: New(),
+        request:   r,
+    }
+}
+
+// Form registers a field read from the request body (r.PostForm).
+// Both url-encoded and multipart forms are supported. A missing key
+// is validated as an empty string. If the body cannot be parsed the
+// field reports an error.
+//
+// Example:
+//
+//    b.Form("email", "Email").Required().Email()
+func (b *RequestValidator) Form(key string, name string) *Field {
+    if err := b.parseForm(); err != nil {
+        f := b.Field(nil, name)
+        f.key = key
+        f.addRule("form", nil, func(f *Field) error {
+            return fmt.Errorf("%s could not be read: %v", f.name, err)
+        })
+        return f
+    }
+    f := b.Field(b.request.PostForm.Get(key), name)
+    f.key = key
+    return f
+}
+
+// Query registers a field read from the URL query string.
+// A missing key is validated as an empty string.
+//
+// Keys may use the bracket syntax of frontend libraries (see
+// ParseBrackets): "tags[]" reads every value of "tags[]=a&tags[]=b" as a
+// []string, nil when none was sent, and "filter[status]" reads the
+// "status" of "filter[status]=open", while "filter" reads the whole object
+// as a map[string]interface{}. The key of the field, used by ErrorsMap, is
+// then the dotted path, such as "filter.status". Query keys that are
+// malformed, such as "filter[status" with an unclosed bracket, are
+// reported as an error of the fields they would belong to. A malformed
+// `key` is a *ConfigError (see Validator.Err).
+//
+// Example:
+//
+//    b.Query("page", "Page").IntegerString().Min(1)
+//    b.Query("tags[]", "Tags").MinItems(1).Each().MaxLength(20)
+//    b.Query("filter[status]", "Status").Optional().OneOf([]interface{}{"open", "closed"})
+func (b *RequestValidator) Query(key string, name string) *Field {
+    segments, err := parseBracketKey(key)
+    if err != nil {
+        f := b.Field(nil, name)
+        f.key = key
+        f.addRule("query", nil, func(f *Field) error {
+            return fmt.Errorf("%s could not be read: %v", f.name, err)
+        })
+        return f.misconfigured(err)
+    }
+
+    value, found, err := bracketValue(b.request.URL.Query(), segments)
+    if err != nil {
+        f := b.Field(nil, name)
+        f.key = bracketPath(segments)
+        f.addRule("query", nil, func(f *Field) error {
+            return fmt.Errorf("%s could not be read: %v", f.name, err)
+        })
+        return f
+    }
+    if !found {
+        value = ""
+        if segments[len(segments)-1] == "" {
+            value = []string(nil)
+        }
+    }
+
+    f := b.Field(value, name)
+    f.key = bracketPath(segments)
+    return f
+}
+
+// Header registers a field read from the request headers.
+// A missing header is validated as an empty string.
+//
+// Example:
+//
+//    b.Header("X-Api-Key", "API Key").Required()
+func (b *RequestValidator) Header(key string, name string) *Field {
+    f := b.Field(b.request.Header.Get(key), name)
+    f.key = key
+    return f
+}
+
+// parseForm parses the request body once, using the multipart
+// parser when the request carries a multipart content type.
+func (b *RequestValidator) parseForm() error {
+    if b.parsed {
+        return b.parseErr
+    }
+    b.parsed = true
+
+    if strings.HasPrefix(b.request.Header.Get("Content-Type"), "multipart/") {
+        err := b.request.ParseMultipartForm(defaultMaxMemory)
+        if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+            b.parseErr = err
+        }
+        return b.parseErr
+    }
+
+    b.parseErr = b.request.ParseForm()
+    return b.parseErr
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+    "errors"
+    "net/http/httptest"
+    "reflect"
+    "testing"
+)
+
+func TestQueryBrackets(t *testing.T) {
+    r := httptest.NewRequest("GET", "/orders?page=2&tags[]=a&tags[]=b&filter[status]=open&filter[owner][id]=7", nil)
+    b := FromRequest(r)
+
+    tests := []struct {
+        key  string
+        want interface{}
+    }{
+        {"page", "2"},
+        {"tags[]", []string{"a", "b"}},
+        {"labels[]", []string(nil)},
+        {"filter[status]", "open"},
+        {"filter[owner][id]", "7"},
+        {"filter[missing]", ""},
+        {"filter", map[string]interface{}{"status": "open", "owner": map[string]interface{}{"id": "7"}}},
+    }
+
+    for _, tt := range tests {
+        if got := b.Query(tt.key, "Value").Value(); !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("Query(%q) = %#v, want %#v", tt.key, got, tt.want)
+        }
+    }
+    if err := b.Err(); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func TestQueryBracketKeys(t *testing.T) {
+    r := httptest.NewRequest("GET", "/orders?filter[status]=archived&tags[]=a", nil)
+    b := FromRequest(r)
+    b.Query("filter[status]", "Status").OneOf([]interface{}{"open", "closed"})
+    b.Query("tags[]", "Tags").MinItems(2)
+
+    want := map[string][]string{
+        "filter.status": {"Status must be one of open, closed"},
+        "tags":          {"Tags must contain at least 2 items"},
+    }
+    if got := ErrorsMap(b.Validate(false)); !reflect.DeepEqual(got, want) {
+        t.Errorf("got %v, want %v", got, want)
+    }
+}
+
+func TestQueryMalformedKeys(t *testing.T) {
+    // A malformed key in the request is the input's fault.
+    r := httptest.NewRequest("GET", "/orders?filter[status=open", nil)
+    b := FromRequest(r)
+    b.Query("filter[status]", "Status")
+    if err := b.Err(); err != nil {
+        t.Errorf("Err() = %v for a malformed query string", err)
+    }
+    if errs := b.Validate(false); len(errs) != 1 {
+        t.Errorf("got errors %v, want one for Status", errs)
+    }
+
+    // A malformed key in the program is a ConfigError.
+    b = FromRequest(httptest.NewRequest("GET", "/orders", nil))
+    b.Query("filter[status", "Status")
+    var cfgErr *ConfigError
+    if err := b.Err(); !errors.As(err, &cfgErr) {
+        t.Errorf("Err() = %v, want a *ConfigError", err)
+    }
+}
@@ -0,0 +1,253 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "regexp"
+    "strings"
+    "unicode"
+)
+
+// EmailOption relaxes the checks of EmailWith.
+// Options can be combined with |.
+type EmailOption int
+
+const (
+    // EmailAllowIDN accepts internationalized domain names, such as
+    // "user@bücher.de", by checking the domain after converting it to
+    // punycode, like Domain does.
+    EmailAllowIDN EmailOption = 1 << iota
+)
+
+var (
+    emailLocalPattern  = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+$`)
+    domainLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+// domainSeparators replaces the full stops that UTS #46 treats as label
+// separators with ".".
+var domainSeparators = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+// EmailWith is Email with options, such as EmailAllowIDN to accept
+// internationalized domains. The local part is checked as by Email.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.EmailWith(validator.EmailAllowIDN) // "info@bücher.de" passes
+func (f *Field) EmailWith(opts EmailOption, messages ...string) *Field {
+    var params []interface{}
+    if opts != 0 {
+        params = []interface{}{opts}
+    }
+
+    f.addRule("email", params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isEmailWith(str, opts) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid email", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isEmailWith reports whether str is an email address accepted by
+// EmailWith with `opts`.
+func isEmailWith(str string, opts EmailOption) bool {
+    at := strings.LastIndex(str, "@")
+    if at <= 0 || !emailLocalPattern.MatchString(str[:at]) {
+        return false
+    }
+    if opts&EmailAllowIDN != 0 {
+        return isDomain(str[at+1:])
+    }
+    return emailPattern.MatchString(str)
+}
+
+// Domain validates that the value is a domain name with at least two
+// labels, such as "example.com". Internationalized names, such as
+// "bücher.de", are converted to punycode ("xn--bcher-kva.de") first, and
+// every label must then be at most 63 letters, digits or hyphens, not
+// starting or ending with a hyphen, with at most 253 characters in total.
+// The top-level domain can't be all digits, so IP addresses fail.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Domain()
+//    f.Domain("Enter a domain such as example.com")
+func (f *Field) Domain(messages ...string) *Field {
+    f.addRule("domain", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isDomain(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid domain name", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// isDomain reports whether str is a domain name as described for Domain.
+func isDomain(str string) bool {
+    ascii, ok := domainToASCII(str)
+    if !ok || len(ascii) > 253 {
+        return false
+    }
+
+    labels := strings.Split(ascii, ".")
+    if len(labels) < 2 {
+        return false
+    }
+    for _, label := range labels {
+        if !domainLabelPattern.MatchString(label) {
+            return false
+        }
+    }
+
+    tld := labels[len(labels)-1]
+    return strings.Trim(tld, "0123456789") != ""
+}
+
+// domainToASCII lowercases and NFC-normalizes a domain name and converts
+// its non-ASCII labels to punycode with the "xn--" prefix. It reports false
+// when a label contains characters other than letters, marks and digits.
+func domainToASCII(domain string) (string, bool) {
+    domain = domainSeparators.Replace(domain)
+    domain = normalizeString(strings.ToLower(domain), true, false)
+
+    labels := strings.Split(domain, ".")
+    for i, label := range labels {
+        ascii := true
+        for _, r := range label {
+            if r >= 0x80 {
+                ascii = false
+                if !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) {
+                    return "", false
+                }
+            }
+        }
+        if ascii {
+            continue
+        }
+
+        encoded, ok := punycodeEncode(label)
+        if !ok {
+            return "", false
+        }
+        labels[i] = "xn--" + encoded
+    }
+    return strings.Join(labels, "."), true
+}
+
+// Punycode parameters from RFC 3492.
+const (
+    punycodeBase        = 36
+    punycodeTMin        = 1
+    punycodeTMax        = 26
+    punycodeSkew        = 38
+    punycodeDamp        = 700
+    punycodeInitialBias = 72
+    punycodeInitialN    = 128
+)
+
+// punycodeEncode encodes label with the Punycode algorithm of RFC 3492,
+// without the "xn--" prefix. It reports false on overflow.
+func punycodeEncode(label string) (string, bool) {
+    runes := []rune(label)
+    var out []byte
+    for _, r := range runes {
+        if r < 0x80 {
+            out = append(out, byte(r))
+        }
+    }
+    basic := len(out)
+    handled := basic
+    if basic > 0 {
+        out = append(out, '-')
+    }
+
+    n, delta, bias := rune(punycodeInitialN), 0, punycodeInitialBias
+    for handled < len(runes) {
+        next := rune(math.MaxInt32)
+        for _, r := range runes {
+            if r >= n && r < next {
+                next = r
+            }
+        }
+        if int(next-n) > (math.MaxInt32-delta)/(handled+1) {
+            return "", false
+        }
+        delta += int(next-n) * (handled + 1)
+        n = next
+
+        for _, r := range runes {
+            if r < n {
+                delta++
+            }
+            if r != n {
+                continue
+            }
+
+            q := delta
+            for k := punycodeBase; ; k += punycodeBase {
+                t := k - bias
+                if t < punycodeTMin {
+                    t = punycodeTMin
+                } else if t > punycodeTMax {
+                    t = punycodeTMax
+                }
+                if q < t {
+                    break
+                }
+                out = append(out, punycodeDigit(t+(q-t)%(punycodeBase-t)))
+                q = (q - t) / (punycodeBase - t)
+            }
+            out = append(out, punycodeDigit(q))
+            bias = punycodeAdapt(delta, handled+1, handled == basic)
+            delta = 0
+            handled++
+        }
+        delta++
+        n++
+    }
+    return string(out), true
+}
+
+// punycodeAdapt is the bias adaptation function of RFC 3492.
+func punycodeAdapt(delta, points int, first bool) int {
+    if first {
+        delta /= punycodeDamp
+    } else {
+        delta /= 2
+    }
+    delta += delta / points
+
+    k := 0
+    for delta > (punycodeBase-punycodeTMin)*punycodeTMax/2 {
+        delta /= punycodeBase - punycodeTMin
+        k += punycodeBase
+    }
+    return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+// punycodeDigit returns the character encoding the digit d.
+func punycodeDigit(d int) byte {
+    if d < 26 {
+        return byte('a' + d)
+    }
+    return byte('0' + d - 26)
+}
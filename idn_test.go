@@ -0,0 +1,95 @@
+package validator
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestPunycodeEncode(t *testing.T) {
+    // Sample strings of RFC 3492, section 7.1, and common domains.
+    tests := []struct {
+        label, want string
+    }{
+        {"bücher", "bcher-kva"},
+        {"münchen", "mnchen-3ya"},
+        {"ü", "tda"},
+        {"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+        {"ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+        {"Pročprostěnemluvíčesky", "Proprostnemluvesky-uyb24dma41a"},
+        {"3年B組金八先生", "3B-ww4c5e180e575a65lsy2b"},
+        {"почемужеонинеговорятпорусски", "b1abfaaepdrnnbgefbadotcwatmq2g4l"},
+    }
+
+    for _, tt := range tests {
+        got, ok := punycodeEncode(tt.label)
+        if !ok || got != tt.want {
+            t.Errorf("punycodeEncode(%q) = %q, %v, want %q", tt.label, got, ok, tt.want)
+        }
+    }
+}
+
+func TestDomain(t *testing.T) {
+    long := strings.Repeat("a", 59) + "ü"
+
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"example.com", true},
+        {"bücher.de", true},
+        {"xn--bcher-kva.de", true},
+        {"BÜCHER.de", true},
+        {"bücher。de", true},
+        {"例え.テスト", true},
+        {strings.Repeat("a", 63) + ".com", true},
+        {strings.Repeat("a", 64) + ".com", false},
+        // 60 characters, but 66 once converted to punycode.
+        {long + ".com", false},
+        {"localhost", false},
+        {"192.168.0.1", false},
+        {"-example.com", false},
+        {"example-.com", false},
+        {"exa_mple.com", false},
+        {"bü☃cher.de", false},
+        {"example..com", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Domain").Domain()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("Domain(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestEmailWithIDN(t *testing.T) {
+    tests := []struct {
+        value string
+        opts  EmailOption
+        valid bool
+    }{
+        {"info@bücher.de", EmailAllowIDN, true},
+        {"info@xn--bcher-kva.de", EmailAllowIDN, true},
+        {"info@xn--bcher-kva.de", 0, true},
+        {"info@bücher.de", 0, false},
+        {"info@" + strings.Repeat("a", 59) + "ü.de", EmailAllowIDN, false},
+        {"jörg@example.com", EmailAllowIDN, false},
+        {"@bücher.de", EmailAllowIDN, false},
+        {"info@bücher", EmailAllowIDN, false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Email").EmailWith(tt.opts)
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("EmailWith(%d) of %q: valid = %v, want %v", tt.opts, tt.value, valid, tt.valid)
+        }
+    }
+
+    v := New()
+    v.Field("info@bücher.de", "Email").EmailWith(0)
+    if got, want := firstError(v), "Email must be a valid email"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
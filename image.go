@@ -0,0 +1,138 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+// ImageRefOption adds requirements to ImageRefWith.
+// Options can be combined with |.
+type ImageRefOption int
+
+const (
+    // ImageRefRequireTag rejects references without a tag, such as "ubuntu".
+    ImageRefRequireTag ImageRefOption = 1 << iota
+    // ImageRefRequireDigest rejects references that are not pinned to a
+    // digest, for supply-chain policies.
+    ImageRefRequireDigest
+)
+
+var (
+    imageDomainPattern    = regexp.MustCompile(`^(localhost|\[[0-9a-fA-F:]+\]|([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(\.([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*)(:[0-9]+)?$`)
+    imagePathPattern      = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+    imageTagPattern       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+    imageDigestPattern    = regexp.MustCompile(`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-fA-F0-9]{32,}$`)
+    imageDigestHexLengths = map[string]int{"sha256": 64, "sha384": 96, "sha512": 128}
+)
+
+// ImageRef validates that the value is a container image reference of the
+// form [registry[:port]/]repository[:tag][@digest], such as "ubuntu" or
+// "registry.example.com:5000/team/app:v1.2@sha256:<64 hex digits>".
+// Repository path components must be lowercase, a tag is at most 128
+// characters of letters, digits, "_", "." and "-", and a sha256, sha384 or
+// sha512 digest must have the matching number of hex digits.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ImageRef()
+//    f.ImageRef("Image must look like registry/repository:tag")
+func (f *Field) ImageRef(messages ...string) *Field {
+    return f.ImageRefWith(0, messages...)
+}
+
+// ImageRefWith is ImageRef with additional requirements, such as a tag or
+// a digest being present.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ImageRefWith(validator.ImageRefRequireTag)    // "ubuntu" fails, "ubuntu:22.04" passes
+//    f.ImageRefWith(validator.ImageRefRequireDigest) // only pinned images pass
+func (f *Field) ImageRefWith(opts ImageRefOption, messages ...string) *Field {
+    f.addRule("imageRef", []interface{}{opts}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var err error
+        if ok {
+            var tag, digest string
+            tag, digest, ok = parseImageRef(str)
+            switch {
+            case !ok:
+            case opts&ImageRefRequireTag != 0 && tag == "":
+                err = fmt.Errorf("%s must include a tag", f.name)
+            case opts&ImageRefRequireDigest != 0 && digest == "":
+                err = fmt.Errorf("%s must include a digest", f.name)
+            }
+        }
+        if !ok {
+            err = fmt.Errorf("%s must be a valid image reference", f.name)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// parseImageRef splits an image reference into its tag and digest,
+// reporting whether the reference is well formed.
+func parseImageRef(ref string) (tag, digest string, ok bool) {
+    name := ref
+    if i := strings.IndexByte(name, '@'); i >= 0 {
+        name, digest = name[:i], name[i+1:]
+        if !isImageDigest(digest) {
+            return "", "", false
+        }
+    }
+
+    // A ":" after the last "/" starts the tag; earlier ones belong to
+    // the registry port.
+    if i := strings.LastIndexByte(name, ':'); i > strings.LastIndexByte(name, '/') {
+        name, tag = name[:i], name[i+1:]
+        if !imageTagPattern.MatchString(tag) {
+            return "", "", false
+        }
+    }
+
+    if name == "" || len(name) > 255 {
+        return "", "", false
+    }
+
+    components := strings.Split(name, "/")
+    // The first component is a registry when it looks like a host name
+    // rather than a repository: it has a dot or a port, or is localhost.
+    if first := components[0]; len(components) > 1 &&
+        (strings.ContainsAny(first, ".:[") || first == "localhost" || first != strings.ToLower(first)) {
+        if !imageDomainPattern.MatchString(first) {
+            return "", "", false
+        }
+        components = components[1:]
+    }
+
+    for _, component := range components {
+        if !imagePathPattern.MatchString(component) {
+            return "", "", false
+        }
+    }
+    return tag, digest, true
+}
+
+// isImageDigest reports whether digest is "algorithm:hex", checking the hex
+// length for well-known algorithms.
+func isImageDigest(digest string) bool {
+    if !imageDigestPattern.MatchString(digest) {
+        return false
+    }
+
+    algorithm, hex, _ := strings.Cut(digest, ":")
+    if want, known := imageDigestHexLengths[algorithm]; known && len(hex) != want {
+        return false
+    }
+    return true
+}
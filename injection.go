@@ -0,0 +1,119 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+)
+
+// shellSafeDefaultChars are the characters besides ASCII letters and digits
+// that ShellSafe allows.
+const shellSafeDefaultChars = "-_./"
+
+// ShellSafe validates that a string only contains ASCII letters, digits and
+// the characters "-", "_", "." and "/", so it holds no spaces, quotes,
+// backticks, "$", ";", "|", "&", redirections or newlines. The error names
+// the first disallowed character.
+//
+// It is a blunt, defense in depth check for values passed to subprocesses:
+// it is not a substitute for passing arguments without a shell (exec.Command)
+// or for proper quoting.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ShellSafe() // "reports/2024-01.csv"
+//    f.ShellSafe("Branch name contains unsupported characters")
+func (f *Field) ShellSafe(messages ...string) *Field {
+    return f.ShellSafeWith(shellSafeDefaultChars, messages...)
+}
+
+// ShellSafeWith is like ShellSafe, but `allowed` lists the characters
+// allowed besides ASCII letters and digits, replacing "-_./". Pass "" to
+// only allow letters and digits.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ShellSafeWith("-_")  // no dots or slashes, so no paths
+//    f.ShellSafeWith("-_.:") // also allow "host:port"
+func (f *Field) ShellSafeWith(allowed string, messages ...string) *Field {
+    f.addRule("shellSafe", []interface{}{allowed}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a string")
+        }
+
+        for _, r := range str {
+            if isASCIIAlphanumeric(r) || (r < 0x80 && strings.ContainsRune(allowed, r)) {
+                continue
+            }
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s contains a disallowed character: %q", f.name, r)
+        }
+        return nil
+    })
+    return f
+}
+
+// NoSQLWildcards validates that a string contains neither "%" nor "_", the
+// wildcards of SQL LIKE patterns, for values that are matched with LIKE.
+// Use EscapeSQLWildcards instead to accept them as literal characters.
+// This is not a substitute for query parameters.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NoSQLWildcards()
+//    f.NoSQLWildcards("Search cannot contain % or _")
+func (f *Field) NoSQLWildcards(messages ...string) *Field {
+    f.addRule("noSQLWildcards", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || strings.ContainsAny(str, "%_") {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain %q or %q", f.name, "%", "_")
+        }
+        return nil
+    })
+    return f
+}
+
+// EscapeSQLWildcards rewrites a string value so it can be used in a SQL
+// LIKE pattern as literal text: "\", "%" and "_" are prefixed with "\",
+// the default escape character of PostgreSQL and MySQL (other databases
+// need ESCAPE '\'). Rules after it see the escaped value, which Value
+// returns after Validate. Values that are not strings are left unchanged.
+//
+// Example:
+//    q := v.Field(search, "Search").MaxLength(100).EscapeSQLWildcards()
+//    // after Validate: "LIKE '%' || $1 || '%'" with q.Value()
+func (f *Field) EscapeSQLWildcards() *Field {
+    f.addRule("escapeSQLWildcards", nil, func(f *Field) error {
+        if str, ok := f.value.(string); ok {
+            f.value = sqlWildcardEscaper.Replace(str)
+        }
+        return nil
+    })
+    return f
+}
+
+// sqlWildcardEscaper escapes the LIKE wildcards and the escape character.
+var sqlWildcardEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// isASCIIAlphanumeric reports whether r is an ASCII letter or digit.
+func isASCIIAlphanumeric(r rune) bool {
+    return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
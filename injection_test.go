@@ -0,0 +1,93 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestShellSafe(t *testing.T) {
+    tests := []struct {
+        value   string
+        message string
+    }{
+        {"reports/2024-01.csv", ""},
+        {"feature_branch", ""},
+        {"", ""},
+        {"`whoami`", "File contains a disallowed character: '`'"},
+        {"$(whoami)", "File contains a disallowed character: '$'"},
+        {"${HOME}", "File contains a disallowed character: '$'"},
+        {"a.csv; rm -rf /", "File contains a disallowed character: ';'"},
+        {"a.csv\nrm -rf /", "File contains a disallowed character: '\\n'"},
+        {"a.csv\rb", "File contains a disallowed character: '\\r'"},
+        {"a.csv | mail", "File contains a disallowed character: ' '"},
+        {"a.csv&&b", "File contains a disallowed character: '&'"},
+        {"a>b", "File contains a disallowed character: '>'"},
+        {"'quoted'", "File contains a disallowed character: '\\''"},
+        {"\"quoted\"", "File contains a disallowed character: '\"'"},
+        {"-rf\\x", "File contains a disallowed character: '\\\\'"},
+        {"caf\u00e9", "File contains a disallowed character: 'é'"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "File").ShellSafe()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("ShellSafe(%q): got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestShellSafeWith(t *testing.T) {
+    tests := []struct {
+        allowed, value string
+        valid          bool
+    }{
+        {"-_", "my-branch_1", true},
+        {"-_", "../etc/passwd", false},
+        {"-_.:", "db.internal:5432", true},
+        {"", "abc123", true},
+        {"", "abc-123", false},
+        {"-_.:", "host:$(id)", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Host").ShellSafeWith(tt.allowed)
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("ShellSafeWith(%q) of %q: valid = %v, want %v", tt.allowed, tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestSQLWildcards(t *testing.T) {
+    tests := []struct {
+        value, escaped string
+        noWildcards    bool
+    }{
+        {"report", "report", true},
+        {"100%", `100\%`, false},
+        {"user_name", `user\_name`, false},
+        {`C:\temp`, `C:\\temp`, true},
+        {`50\%_off`, `50\\\%\_off`, false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Search").NoSQLWildcards()
+        if valid := v.Validate(false) == nil; valid != tt.noWildcards {
+            t.Errorf("NoSQLWildcards(%q): valid = %v, want %v", tt.value, valid, tt.noWildcards)
+        }
+
+        v = New()
+        f := v.Field(tt.value, "Search").EscapeSQLWildcards()
+        v.Validate(false)
+        if got := f.Value(); got != tt.escaped {
+            t.Errorf("EscapeSQLWildcards(%q) = %q, want %q", tt.value, got, tt.escaped)
+        }
+    }
+
+    v := New()
+    v.Field("100%", "Search").NoSQLWildcards()
+    if got, want := firstError(v), `Search cannot contain "%" or "_"`; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
@@ -0,0 +1,123 @@
+package validator
+
+import (
+    "errors"
+    "fmt"
+    "reflect"
+    "strconv"
+)
+
+// Even validates that the value is an even whole number. Integers of any
+// kind, floats without a fraction, such as the float64 of decoded JSON,
+// and whole number strings are accepted, as for Int64Between; other
+// values fail as not being whole numbers. Parity is defined for
+// negative numbers too: -4 is even and -3 is odd.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Even() // 4, -4, 0 and 4.0 pass; 3 and 4.5 fail
+func (f *Field) Even(messages ...string) *Field {
+    f.addRule("even", nil, func(f *Field) error {
+        return checkParity(f, 0, messages)
+    })
+    return f
+}
+
+// Odd validates that the value is an odd whole number, accepting the same
+// values as Even, so -3 is odd.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Odd() // 3, -3 and 3.0 pass; 4 and 3.5 fail
+func (f *Field) Odd(messages ...string) *Field {
+    f.addRule("odd", nil, func(f *Field) error {
+        return checkParity(f, 1, messages)
+    })
+    return f
+}
+
+// checkParity checks that the value of f is a whole number whose
+// remainder of a division by 2, in absolute value, is `want`.
+func checkParity(f *Field, want uint64, messages []string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    var remainder uint64
+    rv := reflect.ValueOf(f.value)
+    switch rv.Kind() {
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        remainder = rv.Uint() % 2
+    default:
+        n, err := int64Value(f)
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return err
+        }
+        // n%2 is -1 for negative odd numbers.
+        if n%2 != 0 {
+            remainder = 1
+        }
+    }
+
+    if remainder != want {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        if want == 0 {
+            return fmt.Errorf("%s must be an even number", f.name)
+        }
+        return fmt.Errorf("%s must be an odd number", f.name)
+    }
+    return nil
+}
+
+// SignedCompatible validates that the value is a whole number that fits in
+// a signed integer of `bits` bits: 8, 16, 32 or 64, such as a value stored
+// in a smallint column with SignedCompatible(16), which accepts -32768 to
+// 32767. It accepts the same values as Even, so 300.0 from JSON passes
+// SignedCompatible(16) while 300.5 fails as not a whole number. Other bit
+// widths are a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.SignedCompatible(16)
+//    // Priority must fit in a signed 16-bit integer
+//    f.SignedCompatible(16, "Priority must be between -32768 and 32767")
+func (f *Field) SignedCompatible(bits int, messages ...string) *Field {
+    f.addRule("signedCompatible", []interface{}{bits}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        n, err := int64Value(f)
+        if (err != nil && beyondInt64(f.value)) || (err == nil && bits < 64 && (n < -1<<(bits-1) || n > 1<<(bits-1)-1)) {
+            err = fmt.Errorf("%s must fit in a signed %d-bit integer", f.name, bits)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+
+    var err error
+    if bits != 8 && bits != 16 && bits != 32 && bits != 64 {
+        err = fmt.Errorf("bit width %d is not 8, 16, 32 or 64", bits)
+    }
+    return f.misconfigured(err)
+}
+
+// beyondInt64 reports whether value is a whole number, or a string of
+// one, that int64Value rejected because it is out of the int64 range.
+func beyondInt64(value interface{}) bool {
+    if str, ok := numericText(value); ok {
+        _, err := strconv.ParseInt(str, 10, 64)
+        return errors.Is(err, strconv.ErrRange)
+    }
+    return isInteger(value)
+}
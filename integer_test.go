@@ -0,0 +1,44 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestSignedCompatible(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        bits    int
+        message string
+    }{
+        {int64(127), 8, ""},
+        {int64(-128), 8, ""},
+        {int64(128), 8, "Priority must fit in a signed 8-bit integer"},
+        {float64(300), 16, ""},
+        {float64(300.5), 16, "Priority must be a whole number"},
+        {"-32769", 16, "Priority must fit in a signed 16-bit integer"},
+        {"9223372036854775808", 64, "Priority must fit in a signed 64-bit integer"},
+        {uint64(1) << 63, 64, "Priority must fit in a signed 64-bit integer"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Priority").SignedCompatible(tt.bits)
+        errs := v.Validate(false)
+
+        got := ""
+        if len(errs) > 0 {
+            got = errs[0].Error()
+        }
+        if got != tt.message {
+            t.Errorf("SignedCompatible(%d) of %v: got %q, want %q", tt.bits, tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestSignedCompatibleBitWidth(t *testing.T) {
+    v := New()
+    v.Field(1, "Priority").SignedCompatible(12)
+    if v.Err() == nil {
+        t.Error("SignedCompatible(12) is not a configuration error")
+    }
+}
@@ -0,0 +1,238 @@
+package validator
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math"
+    "reflect"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// timeType is the reflect.Type of time.Time.
+var timeType = reflect.TypeOf(time.Time{})
+
+// ValidateInto validates every field like Validate(false) and, when no
+// errors are found, stores the field values in the struct `dst` points to,
+// so form and query input can be validated and bound in one step.
+//
+// A field is stored in the struct field whose name or json tag equals its
+// key (ValidationError.Field), ignoring case. The stored value is the one
+// left by the rules, such as the int of IntegerString or the trimmed string
+// of Trim, converted to the struct field's type: numeric strings to number
+// fields, "true" and "false" to bool fields, RFC 3339 strings to time.Time
+// and duration strings such as "1h30m" to time.Duration. Pointer fields are
+// allocated, and lists are converted item by item. Fields that are nil or
+// were skipped as absent leave the struct field unchanged.
+//
+// A value that can't be converted is reported as an error for its field
+// with the rule name "bind". dst is only modified when there are no errors
+// at all. A dst that is not a pointer to a struct is reported as an error.
+//
+// Example:
+//
+//    var params struct {
+//        Page  int    `json:"page"`
+//        Query string `json:"q"`
+//    }
+//    b := validator.FromRequest(r)
+//    b.Query("page", "Page").IntegerString().Min(1)
+//    b.Query("q", "Query").Trim().MaxLength(100)
+//    if errs := b.ValidateInto(&params); errs != nil {
+//        ...
+//    }
+func (v *Validator) ValidateInto(dst interface{}) []error {
+    target := reflect.ValueOf(dst)
+    if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+        return []error{fmt.Errorf("validator: ValidateInto needs a non-nil pointer to a struct, got %T", dst)}
+    }
+
+    res := v.run(context.Background(), 0)
+    if res.err != nil {
+        return append(res.errors, res.err)
+    }
+    if len(res.errors) > 0 {
+        return res.errors
+    }
+
+    validated := make(map[string]bool, len(res.validated))
+    for _, key := range res.validated {
+        validated[key] = true
+    }
+
+    staged := reflect.New(target.Elem().Type()).Elem()
+    staged.Set(target.Elem())
+    var errs []error
+    for _, f := range v.fields {
+        if !validated[f.fieldKey()] || f.value == nil {
+            continue
+        }
+        field, ok := structFieldByKey(staged, f.fieldKey())
+        if !ok {
+            continue
+        }
+        if err := assignValue(field, f.value); err != nil {
+            errs = append(errs, ValidationError{
+                Field:   f.fieldKey(),
+                Rule:    "bind",
+                Message: fmt.Sprintf("%s %s", f.name, err),
+                Err:     err,
+            })
+        }
+    }
+
+    if len(errs) > 0 {
+        return errs
+    }
+    target.Elem().Set(staged)
+    return nil
+}
+
+// structFieldByKey returns the settable field of the struct rv whose name
+// or json tag name equals key, ignoring case.
+func structFieldByKey(rv reflect.Value, key string) (reflect.Value, bool) {
+    rt := rv.Type()
+    for i := 0; i < rt.NumField(); i++ {
+        sf := rt.Field(i)
+        if !sf.IsExported() {
+            continue
+        }
+        tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+        if strings.EqualFold(sf.Name, key) || (tag != "" && tag != "-" && strings.EqualFold(tag, key)) {
+            return rv.Field(i), true
+        }
+    }
+    return reflect.Value{}, false
+}
+
+// assignValue converts value to the type of dst and stores it. The error
+// completes a message starting with the field name, such as "must be an
+// integer".
+func assignValue(dst reflect.Value, value interface{}) error {
+    src := reflect.ValueOf(value)
+    if src.Type().AssignableTo(dst.Type()) {
+        dst.Set(src)
+        return nil
+    }
+
+    switch {
+    case dst.Kind() == reflect.Ptr:
+        elem := reflect.New(dst.Type().Elem())
+        if err := assignValue(elem.Elem(), value); err != nil {
+            return err
+        }
+        dst.Set(elem)
+        return nil
+    case dst.Type() == timeType:
+        str, ok := value.(string)
+        t, err := time.Parse(time.RFC3339Nano, str)
+        if !ok || err != nil {
+            return fmt.Errorf("must be an RFC 3339 timestamp")
+        }
+        dst.Set(reflect.ValueOf(t))
+        return nil
+    case dst.Type() == reflect.TypeOf(time.Duration(0)):
+        if str, ok := value.(string); ok {
+            d, err := time.ParseDuration(str)
+            if err != nil {
+                return fmt.Errorf("must be a duration such as 1h30m")
+            }
+            dst.SetInt(int64(d))
+            return nil
+        }
+    }
+
+    switch dst.Kind() {
+    case reflect.String:
+        if src.Kind() == reflect.String {
+            dst.SetString(src.String())
+            return nil
+        }
+    case reflect.Bool:
+        if str, ok := value.(string); ok {
+            b, err := strconv.ParseBool(str)
+            if err != nil {
+                return fmt.Errorf("must be true or false")
+            }
+            dst.SetBool(b)
+            return nil
+        }
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        if str, ok := value.(string); ok {
+            n, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+            if err != nil && !errors.Is(err, strconv.ErrRange) {
+                return fmt.Errorf("must be an integer")
+            }
+            if err != nil || dst.OverflowInt(n) {
+                return fmt.Errorf("is out of range")
+            }
+            dst.SetInt(n)
+            return nil
+        }
+        n, ok := numberValue(value)
+        if !ok || n != math.Trunc(n) {
+            return fmt.Errorf("must be an integer")
+        }
+        if n < math.MinInt64 || n >= math.MaxInt64 || dst.OverflowInt(int64(n)) {
+            return fmt.Errorf("is out of range")
+        }
+        dst.SetInt(int64(n))
+        return nil
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        if str, ok := value.(string); ok {
+            n, err := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+            if err != nil && !errors.Is(err, strconv.ErrRange) {
+                return fmt.Errorf("must be a whole number")
+            }
+            if err != nil || dst.OverflowUint(n) {
+                return fmt.Errorf("is out of range")
+            }
+            dst.SetUint(n)
+            return nil
+        }
+        n, ok := numberValue(value)
+        if !ok || n != math.Trunc(n) {
+            return fmt.Errorf("must be a whole number")
+        }
+        if n < 0 || n >= math.MaxUint64 || dst.OverflowUint(uint64(n)) {
+            return fmt.Errorf("is out of range")
+        }
+        dst.SetUint(uint64(n))
+        return nil
+    case reflect.Float32, reflect.Float64:
+        n, ok := numberValue(value)
+        if !ok {
+            return fmt.Errorf("must be a number")
+        }
+        if dst.OverflowFloat(n) {
+            return fmt.Errorf("is out of range")
+        }
+        dst.SetFloat(n)
+        return nil
+    case reflect.Slice:
+        items, ok := sliceValue(value)
+        if !ok {
+            break
+        }
+        list := reflect.MakeSlice(dst.Type(), items.Len(), items.Len())
+        for i := 0; i < items.Len(); i++ {
+            if err := assignValue(list.Index(i), items.Index(i).Interface()); err != nil {
+                return fmt.Errorf("item %d %s", i, err)
+            }
+        }
+        dst.Set(list)
+        return nil
+    }
+    return fmt.Errorf("cannot be stored as %s", dst.Type())
+}
+
+// numberValue returns a number, or a string holding one, as a float64.
+func numberValue(value interface{}) (float64, bool) {
+    if str, ok := value.(string); ok {
+        n, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+        return n, err == nil && !math.IsNaN(n) && !math.IsInf(n, 0)
+    }
+    return toFloat64(value)
+}
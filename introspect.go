@@ -0,0 +1,47 @@
+package validator
+
+// FieldInfo describes a registered field and the rules it will check.
+type FieldInfo struct {
+    Name  string
+    Key   string
+    Rules []RuleInfo
+}
+
+// RuleInfo describes a single rule: its name, such as "minLength",
+// and the parameters it was registered with, such as [3].
+type RuleInfo struct {
+    Name   string
+    Params []interface{}
+}
+
+// Fields lists the registered fields and their rules in registration order.
+// It is meant for debugging, documentation, and tests asserting that a
+// validator covers every field of a request.
+//
+// Example:
+//
+//    for _, field := range v.Fields() {
+//        fmt.Println(field.Name, field.Rules)
+//    }
+func (v *Validator) Fields() []FieldInfo {
+    return fieldInfos(v.root().fields)
+}
+
+// Fields lists the fields declared on the schema and their rules.
+func (s *Schema) Fields() []FieldInfo {
+    return fieldInfos(s.fields)
+}
+
+// fieldInfos builds the FieldInfo for each field.
+func fieldInfos(fields []*Field) []FieldInfo {
+    infos := make([]FieldInfo, 0, len(fields))
+    for _, f := range fields {
+        info := FieldInfo{Name: f.name, Key: f.fieldKey(), Rules: make([]RuleInfo, 0, len(f.rules))}
+        for _, r := range f.rules {
+            params := append([]interface{}(nil), r.params...)
+            info.Rules = append(info.Rules, RuleInfo{Name: r.name, Params: params})
+        }
+        infos = append(infos, info)
+    }
+    return infos
+}
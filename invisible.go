@@ -0,0 +1,132 @@
+package validator
+
+import (
+    "fmt"
+    "unicode"
+)
+
+// bidiControls are the directional embedding, override and isolate
+// characters, which reorder the text around them: U+202A–U+202E and
+// U+2066–U+2069.
+var bidiControls = &unicode.RangeTable{
+    R16: []unicode.Range16{
+        {Lo: 0x202a, Hi: 0x202e, Stride: 1},
+        {Lo: 0x2066, Hi: 0x2069, Stride: 1},
+    },
+}
+
+// defaultIgnorables are the Default_Ignorable_Code_Point characters of
+// Unicode 15.1, which are not displayed: soft hyphens, zero-width spaces
+// and joiners, directional marks and controls, invisible operators,
+// fillers, variation selectors, the byte order mark and tag characters.
+var defaultIgnorables = &unicode.RangeTable{
+    R16: []unicode.Range16{
+        {Lo: 0x00ad, Hi: 0x00ad, Stride: 1},
+        {Lo: 0x034f, Hi: 0x034f, Stride: 1},
+        {Lo: 0x061c, Hi: 0x061c, Stride: 1},
+        {Lo: 0x115f, Hi: 0x1160, Stride: 1},
+        {Lo: 0x17b4, Hi: 0x17b5, Stride: 1},
+        {Lo: 0x180b, Hi: 0x180f, Stride: 1},
+        {Lo: 0x200b, Hi: 0x200f, Stride: 1},
+        {Lo: 0x202a, Hi: 0x202e, Stride: 1},
+        {Lo: 0x2060, Hi: 0x206f, Stride: 1},
+        {Lo: 0x3164, Hi: 0x3164, Stride: 1},
+        {Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+        {Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
+        {Lo: 0xffa0, Hi: 0xffa0, Stride: 1},
+        {Lo: 0xfff0, Hi: 0xfff8, Stride: 1},
+    },
+    R32: []unicode.Range32{
+        {Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+        {Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
+        {Lo: 0xe0000, Hi: 0xe0fff, Stride: 1},
+    },
+}
+
+// NoBidiControl validates that a string contains none of the directional
+// formatting characters that reorder text, U+202A–U+202E (embeddings and
+// overrides, such as RIGHT-TO-LEFT OVERRIDE) and U+2066–U+2069 (isolates),
+// which can make a name display differently from what it is. Directional
+// marks such as U+200F are allowed; NoInvisibleChars rejects them.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NoBidiControl()
+func (f *Field) NoBidiControl(messages ...string) *Field {
+    f.addRule("noBidiControl", nil, func(f *Field) error {
+        str, ok := f.value.(string)
+        if ok {
+            for _, r := range str {
+                if unicode.Is(bidiControls, r) {
+                    ok = false
+                    break
+                }
+            }
+        }
+        return checkFormatting(f, ok, messages)
+    })
+    return f
+}
+
+// NoInvisibleChars validates that a string contains no characters that
+// are not displayed, the Default_Ignorable_Code_Point characters of Unicode:
+// zero-width spaces, joiners and non-joiners, soft hyphens, directional
+// marks and controls, the byte order mark, fillers such as U+3164 and
+// others. Characters that are part of an emoji are allowed, so emoji
+// accepted by MaxEmoji still pass: a zero-width joiner between two emoji,
+// a variation selector after an emoji or keycap digit, and the tag
+// characters of subdivision flags.
+// Accepts an optional custom error message.
+//
+// Example:
+//    v.Field(name, "Display Name").NoBidiControl().NoInvisibleChars()
+func (f *Field) NoInvisibleChars(messages ...string) *Field {
+    f.addRule("noInvisibleChars", nil, func(f *Field) error {
+        str, ok := f.value.(string)
+        if ok {
+            runes := []rune(str)
+            for i, r := range runes {
+                if unicode.Is(defaultIgnorables, r) && !inEmojiSequence(runes, i) {
+                    ok = false
+                    break
+                }
+            }
+        }
+        return checkFormatting(f, ok, messages)
+    })
+    return f
+}
+
+// inEmojiSequence reports whether the ignorable character runes[i] belongs
+// to an emoji sequence.
+func inEmojiSequence(runes []rune, i int) bool {
+    if i == 0 {
+        return false
+    }
+    prev := runes[i-1]
+    afterEmoji := isEmojiRune(prev) || isEmojiModifier(prev)
+
+    switch r := runes[i]; {
+    case r == zeroWidthJoiner:
+        return afterEmoji && i+1 < len(runes) && isEmojiRune(runes[i+1])
+    case r == variationSelector:
+        keycap := (prev == '#' || prev == '*' || (prev >= '0' && prev <= '9')) &&
+            i+1 < len(runes) && runes[i+1] == combiningKeycap
+        return afterEmoji || keycap
+    case r >= 0xe0020 && r <= 0xe007f:
+        return afterEmoji
+    }
+    return false
+}
+
+// checkFormatting returns the error of NoBidiControl and NoInvisibleChars,
+// which doesn't name the characters found since they can't be seen.
+func checkFormatting(f *Field, ok bool, messages []string) error {
+    if ok {
+        return nil
+    }
+    if len(messages) > 0 {
+        return fmt.Errorf("%s", messages[0])
+    }
+    return fmt.Errorf("%s cannot contain invisible or directional formatting characters", f.name)
+}
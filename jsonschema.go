@@ -0,0 +1,375 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect emitted by ToJSONSchema.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// ToJSONSchema describes the registered fields as a draft 2020-12 JSON Schema
+// object, so the same rules can be mirrored client-side.
+//
+// Rules are mapped as follows:
+//   - Required, RequiredNonBlank and Present add the field to "required"
+//   - String, Number, Integer, Bool, MinItems and MaxItems set "type"
+//   - MinRunes, MaxRunes, Min, Max, MinItems and MaxItems set their keyword
+//   - Matches, MatchesExtract and the identifier case rules set "pattern" and OneOf sets "enum"
+//   - Email, Url, UUID and Date set "format" to email, uri, uuid and date
+// Rules without an equivalent, such as Custom, are skipped; attach a
+// Description() to the field to document them. JSON Schema counts the
+// length of strings in code points, so MinLength and MaxLength, which
+// count bytes, are skipped too; use MinRunes and MaxRunes for limits the
+// client should mirror. Called on a view returned by Scope, it describes
+// every field of the validator.
+//
+// Example:
+//
+//    schema, err := v.ToJSONSchema()
+func (v *Validator) ToJSONSchema() ([]byte, error) {
+    return toJSONSchema(v.root().fields)
+}
+
+// ToJSONSchema describes the schema's fields as a draft 2020-12 JSON Schema.
+// See Validator.ToJSONSchema for how rules are mapped.
+func (s *Schema) ToJSONSchema() ([]byte, error) {
+    return toJSONSchema(s.fields)
+}
+
+// toJSONSchema builds the JSON Schema document for a list of fields.
+// Properties are keyed by the field key, or its name when it has no key.
+func toJSONSchema(fields []*Field) ([]byte, error) {
+    properties := map[string]interface{}{}
+    required := []string{}
+    seen := map[string]bool{}
+
+    for _, f := range fields {
+        key := f.fieldKey()
+        property, ok := properties[key].(map[string]interface{})
+        if !ok {
+            property = map[string]interface{}{}
+            properties[key] = property
+        }
+
+        isRequired := false
+        for _, r := range f.rules {
+            if r.warning || r.each {
+                continue
+            }
+            switch r.name {
+            case "required", "requiredNonBlank", "present":
+                isRequired = true
+            case "string":
+                property["type"] = "string"
+            case "number":
+                property["type"] = "number"
+            case "integer":
+                property["type"] = "integer"
+            case "bool":
+                property["type"] = "boolean"
+            case "email":
+                property["type"] = "string"
+                property["format"] = "email"
+                if len(r.params) > 0 && r.params[0].(EmailOption)&EmailAllowIDN != 0 {
+                    property["format"] = "idn-email"
+                }
+            case "domain":
+                property["type"] = "string"
+                property["format"] = "idn-hostname"
+            case "url":
+                property["type"] = "string"
+                property["format"] = "uri"
+            case "uuid":
+                property["type"] = "string"
+                property["format"] = "uuid"
+            case "date":
+                property["type"] = "string"
+                property["format"] = "date"
+            case "minRunes":
+                property["minLength"] = r.params[0]
+            case "maxRunes":
+                property["maxLength"] = r.params[0]
+            case "min":
+                property["minimum"] = r.params[0]
+            case "max":
+                property["maximum"] = r.params[0]
+            case "minItems":
+                property["type"] = "array"
+                property["minItems"] = r.params[0]
+            case "maxItems":
+                property["type"] = "array"
+                property["maxItems"] = r.params[0]
+            case "matches":
+                property["pattern"] = r.params[0]
+            case "matchesExtract":
+                property["pattern"] = fmt.Sprintf("^(?:%v)$", r.params[0])
+            case "identifierCase":
+                if c, ok := identifierCases[r.params[0].(string)]; ok {
+                    property["pattern"] = c.pattern.String()
+                }
+            case "oneOf":
+                property["enum"] = r.params
+            }
+        }
+
+        // Required rejects empty strings, which JSON Schema
+        // only expresses through minLength.
+        if isRequired && property["type"] == "string" {
+            if _, ok := property["minLength"]; !ok && (hasRule(f, "required") || hasRule(f, "requiredNonBlank")) {
+                property["minLength"] = 1
+            }
+        }
+
+        if f.desc != "" {
+            property["description"] = f.desc
+        }
+
+        if isRequired && !seen[key] {
+            seen[key] = true
+            required = append(required, key)
+        }
+    }
+
+    document := map[string]interface{}{
+        "$schema":    jsonSchemaDraft,
+        "type":       "object",
+        "properties": properties,
+    }
+    if len(required) > 0 {
+        document["required"] = required
+    }
+    return json.MarshalIndent(document, "", "  ")
+}
+
+// hasRule reports whether a rule with the given name is registered on f.
+func hasRule(f *Field, name string) bool {
+    for _, r := range f.rules {
+        if r.name == name {
+            return true
+        }
+    }
+    return false
+}
+
+// FromJSONSchema builds a reusable Schema from a subset of JSON Schema,
+// validating map[string]interface{} documents with Schema.ValidateMap.
+//
+// The document must describe an object. Supported property keywords are
+// type (string, number, integer, boolean), minLength, maxLength, minimum,
+// maximum, pattern, enum, format (email, uuid, uri, date), title and
+// description. minLength and maxLength count code points, as MinRunes and
+// MaxRunes do, and minimum and maximum may have a fraction, such as 0.5.
+// Any other keyword is reported as an error when the schema is loaded
+// rather than silently ignored. Properties listed in "required" must be
+// present; other properties are only checked when present.
+//
+// Example:
+//
+//    s, err := validator.FromJSONSchema(partnerSchema)
+//    if err != nil {
+//        return err
+//    }
+//    errs := s.ValidateMap(payload, false)
+func FromJSONSchema(schema []byte) (*Schema, error) {
+    var document map[string]interface{}
+    if err := json.Unmarshal(schema, &document); err != nil {
+        return nil, fmt.Errorf("validator: invalid JSON Schema: %w", err)
+    }
+
+    var unsupported []string
+    for keyword := range document {
+        switch keyword {
+        case "$schema", "$id", "title", "description", "type", "properties", "required":
+        default:
+            unsupported = append(unsupported, keyword)
+        }
+    }
+    if t, ok := document["type"]; ok && t != "object" {
+        return nil, fmt.Errorf("validator: JSON Schema type must be \"object\", got %v", t)
+    }
+
+    properties, _ := document["properties"].(map[string]interface{})
+    if raw, ok := document["properties"]; ok && properties == nil {
+        return nil, fmt.Errorf("validator: JSON Schema properties must be an object, got %T", raw)
+    }
+
+    required := map[string]bool{}
+    if raw, ok := document["required"]; ok {
+        list, ok := raw.([]interface{})
+        if !ok {
+            return nil, fmt.Errorf("validator: JSON Schema required must be a list, got %T", raw)
+        }
+        for _, item := range list {
+            key, ok := item.(string)
+            if !ok {
+                return nil, fmt.Errorf("validator: JSON Schema required entries must be strings, got %T", item)
+            }
+            required[key] = true
+        }
+    }
+
+    keys := make([]string, 0, len(properties))
+    for key := range properties {
+        keys = append(keys, key)
+    }
+    for key := range required {
+        if _, ok := properties[key]; !ok {
+            keys = append(keys, key)
+        }
+    }
+    sort.Strings(keys)
+
+    s := NewSchema(nil)
+    var problems []string
+    for _, key := range keys {
+        f := s.Field(key)
+        if required[key] {
+            f.Present()
+        } else {
+            f.omitAbsent = true
+        }
+
+        property, ok := properties[key].(map[string]interface{})
+        if !ok {
+            if _, declared := properties[key]; declared {
+                problems = append(problems, fmt.Sprintf("properties.%s must be an object", key))
+            }
+            continue
+        }
+
+        bad, problem := applyJSONSchemaProperty(f, property)
+        for _, keyword := range bad {
+            unsupported = append(unsupported, "properties."+key+"."+keyword)
+        }
+        if problem != "" {
+            problems = append(problems, fmt.Sprintf("properties.%s: %s", key, problem))
+        }
+    }
+
+    if len(unsupported) > 0 {
+        sort.Strings(unsupported)
+        problems = append(problems, "unsupported keywords: "+strings.Join(unsupported, ", "))
+    }
+    if len(problems) > 0 {
+        return nil, fmt.Errorf("validator: cannot load JSON Schema: %s", strings.Join(problems, "; "))
+    }
+    return s, nil
+}
+
+// addJSONSchemaBound registers the "min" or "max" rule of a minimum or
+// maximum, which may have a fraction, such as 0.5, unlike the bound of
+// Min and Max. The errors are worded like theirs.
+func addJSONSchemaBound(f *Field, isMin bool, bound float64) {
+    name := "max"
+    if isMin {
+        name = "min"
+    }
+
+    f.addRule(name, []interface{}{bound}, func(f *Field) error {
+        value, ok := toFloat64(f.value)
+        switch {
+        case !ok:
+            return mismatch(f, "a number")
+        case isMin && value < bound:
+            return fmt.Errorf("%v cannot be less than %v", f.value, bound)
+        case !isMin && value > bound:
+            return fmt.Errorf("%v cannot be greater than %v", f.value, bound)
+        }
+        return nil
+    })
+}
+
+// applyJSONSchemaProperty registers the rules described by a single
+// property schema on f. It returns the unsupported keywords found and
+// a description of the first invalid keyword value, if any.
+func applyJSONSchemaProperty(f *Field, property map[string]interface{}) ([]string, string) {
+    var unsupported []string
+
+    keywords := make([]string, 0, len(property))
+    for keyword := range property {
+        keywords = append(keywords, keyword)
+    }
+    sort.Strings(keywords)
+
+    // type is applied first so that the type error comes before the others.
+    if t, ok := property["type"]; ok {
+        switch t {
+        case "string":
+            f.String()
+        case "number":
+            f.Number()
+        case "integer":
+            f.Integer()
+        case "boolean":
+            f.Bool()
+        default:
+            return nil, fmt.Sprintf("unsupported type %v", t)
+        }
+    }
+
+    for _, keyword := range keywords {
+        value := property[keyword]
+        switch keyword {
+        case "type":
+        case "title":
+        case "description":
+            if text, ok := value.(string); ok {
+                f.Description(text)
+            }
+        case "minLength", "maxLength":
+            n, ok := value.(float64)
+            if !ok || n != math.Trunc(n) || n < 0 {
+                return nil, fmt.Sprintf("%s must be a non-negative whole number, got %v", keyword, value)
+            }
+            if keyword == "minLength" {
+                f.MinRunes(int(n))
+            } else {
+                f.MaxRunes(int(n))
+            }
+        case "minimum", "maximum":
+            n, ok := value.(float64)
+            if !ok {
+                return nil, fmt.Sprintf("%s must be a number, got %v", keyword, value)
+            }
+            addJSONSchemaBound(f, keyword == "minimum", n)
+        case "pattern":
+            pattern, ok := value.(string)
+            if !ok {
+                return nil, fmt.Sprintf("pattern must be a string, got %T", value)
+            }
+            if _, err := regexp.Compile(pattern); err != nil {
+                return nil, fmt.Sprintf("invalid pattern: %v", err)
+            }
+            f.Matches(pattern)
+        case "enum":
+            values, ok := value.([]interface{})
+            if !ok {
+                return nil, fmt.Sprintf("enum must be a list, got %T", value)
+            }
+            f.OneOf(values)
+        case "format":
+            switch value {
+            case "email":
+                f.Email()
+            case "idn-email":
+                f.EmailWith(EmailAllowIDN)
+            case "uuid":
+                f.UUID()
+            case "uri":
+                f.Url()
+            case "date":
+                f.Date()
+            default:
+                return nil, fmt.Sprintf("unsupported format %v", value)
+            }
+        default:
+            unsupported = append(unsupported, keyword)
+        }
+    }
+    return unsupported, ""
+}
@@ -0,0 +1,148 @@
+package validator
+
+import (
+    "bytes"
+    "flag"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// signupValidator registers a representative set of fields, in a scope
+// like those of a nested request.
+func signupValidator() *Validator {
+    v := New()
+    v.Field("ada@example.com", "Email").Required().Email().MaxRunes(254)
+    v.Field("Ada", "Name").String().MinRunes(2).MaxLength(64)
+    v.Field(36, "Age").Integer().Min(18).Max(120)
+    v.Field(true, "Newsletter").Bool()
+    v.Field("pro", "Plan").OneOf([]interface{}{"free", "pro", "team"})
+    v.Field([]interface{}{"go"}, "Tags").MinItems(1).MaxItems(5)
+    v.Field("SAVE-10", "Promo Code").Matches(`^[A-Z]+-[0-9]+$`).
+        Custom(func(value interface{}) error { return nil }).
+        Description("Must be an active promo code")
+    v.Scope("Profile", func(sv *Validator) {
+        sv.Field("https://ada.dev", "Website").Url()
+        sv.Field("1815-12-10", "Born").Date()
+        sv.Field("0f8fad5b-d9cb-469f-a165-70867728950e", "ID").Required().UUID()
+    })
+    return v
+}
+
+func TestToJSONSchemaGolden(t *testing.T) {
+    got, err := signupValidator().ToJSONSchema()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    golden := filepath.Join("testdata", "signup.schema.json")
+    if *update {
+        if err := os.WriteFile(golden, append(got, '\n'), 0o644); err != nil {
+            t.Fatal(err)
+        }
+    }
+    want, err := os.ReadFile(golden)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(append(got, '\n'), want) {
+        t.Errorf("ToJSONSchema() differs from %s:\n%s", golden, got)
+    }
+}
+
+func TestToJSONSchemaFromScope(t *testing.T) {
+    v := New()
+    v.Field("ada@example.com", "Email").Required()
+    var scoped *Validator
+    v.Scope("Profile", func(sv *Validator) {
+        sv.Field("Ada", "Name").MinRunes(2)
+        scoped = sv
+    })
+
+    fromRoot, err := v.ToJSONSchema()
+    if err != nil {
+        t.Fatal(err)
+    }
+    fromScope, err := scoped.ToJSONSchema()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(fromRoot, fromScope) {
+        t.Errorf("schema of the scope:\n%s\nwant the schema of the validator:\n%s", fromScope, fromRoot)
+    }
+}
+
+func TestFromJSONSchema(t *testing.T) {
+    s, err := FromJSONSchema([]byte(`{
+        "type": "object",
+        "properties": {
+            "name": {"type": "string", "minLength": 2, "maxLength": 5},
+            "ratio": {"type": "number", "minimum": 0.5, "maximum": 99.5},
+            "age": {"type": "integer", "minimum": 18}
+        },
+        "required": ["name"]
+    }`))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    tests := []struct {
+        data  map[string]interface{}
+        valid bool
+    }{
+        {map[string]interface{}{"name": "ééééé"}, true},
+        {map[string]interface{}{"name": "éééééé"}, false},
+        {map[string]interface{}{"name": "é"}, false},
+        {map[string]interface{}{"name": "Ada", "ratio": 0.5}, true},
+        {map[string]interface{}{"name": "Ada", "ratio": 99.5}, true},
+        {map[string]interface{}{"name": "Ada", "ratio": 0.49}, false},
+        {map[string]interface{}{"name": "Ada", "ratio": 99.51}, false},
+        {map[string]interface{}{"name": "Ada", "age": float64(18)}, true},
+        {map[string]interface{}{"name": "Ada", "age": float64(17)}, false},
+        {map[string]interface{}{}, false},
+    }
+
+    for _, tt := range tests {
+        if valid := s.ValidateMap(tt.data, false) == nil; valid != tt.valid {
+            t.Errorf("ValidateMap(%v): valid = %v, want %v", tt.data, valid, tt.valid)
+        }
+    }
+}
+
+func TestFromJSONSchemaErrors(t *testing.T) {
+    tests := []string{
+        `{"properties": {"name": {"minLength": 1.5}}}`,
+        `{"properties": {"name": {"maxLength": -1}}}`,
+        `{"properties": {"ratio": {"minimum": "0.5"}}}`,
+        `{"properties": {"name": {"minProperties": 1}}}`,
+    }
+
+    for _, schema := range tests {
+        if _, err := FromJSONSchema([]byte(schema)); err == nil {
+            t.Errorf("FromJSONSchema(%s) succeeded", schema)
+        }
+    }
+}
+
+func TestJSONSchemaRoundTrip(t *testing.T) {
+    v := New()
+    v.Field("Ada", "name").Required().String().MinRunes(2).MaxRunes(5)
+    v.Field(36, "age").Integer().Min(18).Max(120)
+    exported, err := v.ToJSONSchema()
+    if err != nil {
+        t.Fatal(err)
+    }
+    s, err := FromJSONSchema(exported)
+    if err != nil {
+        t.Fatal(err)
+    }
+    again, err := s.ToJSONSchema()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(exported, again) {
+        t.Errorf("schema changed by a round trip:\n%s\nwant:\n%s", again, exported)
+    }
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+)
+
+var (
+    dns1123LabelPattern     = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+    dns1123SubdomainPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+    k8sLabelValuePattern    = regexp.MustCompile(`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`)
+)
+
+// DNS1123Label validates that the value is an RFC 1123 label, the format
+// Kubernetes requires for most object names: at most 63 lowercase
+// alphanumeric characters or "-", starting and ending with an alphanumeric
+// character. The error names the violated constraint.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DNS1123Label()
+//    f.DNS1123Label("Namespace is not a valid name")
+func (f *Field) DNS1123Label(messages ...string) *Field {
+    f.addRule("dns1123Label", nil, func(f *Field) error {
+        return checkK8sName(f, messages, 63, dns1123LabelPattern,
+            "must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character")
+    })
+    return f
+}
+
+// DNS1123Subdomain validates that the value is an RFC 1123 subdomain:
+// at most 253 characters of dot-separated labels made of lowercase
+// alphanumeric characters or "-", each starting and ending with an
+// alphanumeric character. The error names the violated constraint.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.DNS1123Subdomain() // "config.example.com"
+func (f *Field) DNS1123Subdomain(messages ...string) *Field {
+    f.addRule("dns1123Subdomain", nil, func(f *Field) error {
+        return checkK8sName(f, messages, 253, dns1123SubdomainPattern,
+            "must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+    })
+    return f
+}
+
+// K8sLabelValue validates that the value can be used as a Kubernetes label
+// value: empty, or at most 63 alphanumeric characters, "-", "_" or ".",
+// starting and ending with an alphanumeric character. The error names the
+// violated constraint.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.K8sLabelValue() // "v1.2.0", "frontend_blue", ""
+func (f *Field) K8sLabelValue(messages ...string) *Field {
+    f.addRule("k8sLabelValue", nil, func(f *Field) error {
+        return checkK8sName(f, messages, 63, k8sLabelValuePattern,
+            "must be empty or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character")
+    })
+    return f
+}
+
+// checkK8sName checks the value of f against a maximum length and a
+// pattern, returning the custom message or an error stating `format`
+// when the pattern doesn't match.
+func checkK8sName(f *Field, messages []string, maxLen int, pattern *regexp.Regexp, format string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, ok := f.value.(string)
+    var err error
+    switch {
+    case !ok:
+        err = mismatch(f, "a string")
+    case len(str) > maxLen:
+        err = fmt.Errorf("%s must be no more than %d characters", f.name, maxLen)
+    case !pattern.MatchString(str):
+        err = fmt.Errorf("%s %s", f.name, format)
+    }
+
+    if err != nil && message != "" {
+        return fmt.Errorf("%s", message)
+    }
+    return err
+}
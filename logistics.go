@@ -0,0 +1,114 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+var (
+    containerCodePattern = regexp.MustCompile(`^[A-Z]{3}[UJZ][0-9]{7}$`)
+    imoNumberPattern     = regexp.MustCompile(`^(?:IMO)?([0-9]{7})$`)
+)
+
+// ContainerCode validates that the value is a shipping container code as
+// defined by ISO 6346, such as "CSQU3054383": a three-letter owner code, the
+// equipment category (U for freight containers, J for detachable equipment
+// or Z for trailers and chassis), a six-digit serial number and the check
+// digit computed from the other ten characters. Lowercase letters and the
+// spaces and hyphens often printed between the groups, as in
+// "csqu 305438-3", are accepted, and the value becomes the canonical code,
+// "CSQU3054383". Sample codes with a made-up check digit fail, such as
+// "MSCU1234565", whose check digit is 6. The error does not repeat the
+// code.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ContainerCode()
+//    f.ContainerCode("Enter the container number shown on the door, such as CSQU3054383")
+func (f *Field) ContainerCode(messages ...string) *Field {
+    f.addRule("containerCode", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        code := strings.ToUpper(deviceIDSeparators.Replace(str))
+        if !ok || !isContainerCode(code) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid container code, such as CSQU3054383", f.name)
+        }
+        f.value = code
+        return nil
+    })
+    return f
+}
+
+// isContainerCode reports whether code, in upper case without separators,
+// is an ISO 6346 container code with a valid check digit.
+func isContainerCode(code string) bool {
+    if !containerCodePattern.MatchString(code) {
+        return false
+    }
+
+    // Letters are worth 10 to 38, skipping the multiples of 11, and each
+    // character is weighted by 2 to the power of its position.
+    sum := 0
+    for i := 0; i < 10; i++ {
+        value := int(code[i] - '0')
+        if code[i] >= 'A' {
+            value = int(code[i]-'A') + 10
+            value += (value - 1) / 10
+        }
+        sum += value << i
+    }
+    return sum%11%10 == int(code[10]-'0')
+}
+
+// IMONumber validates that the value is the IMO number of a ship, seven
+// digits of which the last is a check digit, such as "9074729", optionally
+// written after "IMO" as in "IMO 9074729". The check digit is the last
+// digit of the sum of the first six digits weighted 7 down to 2. The
+// prefix may be in any case, and the value becomes "IMO 9074729". The
+// error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IMONumber()
+//    f.IMONumber("Enter the vessel's 7-digit IMO number")
+func (f *Field) IMONumber(messages ...string) *Field {
+    f.addRule("imoNumber", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var match []string
+        if ok {
+            match = imoNumberPattern.FindStringSubmatch(strings.ToUpper(strings.ReplaceAll(str, " ", "")))
+        }
+        if match == nil || !isIMONumber(match[1]) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid IMO number, such as IMO 9074729", f.name)
+        }
+        f.value = "IMO " + match[1]
+        return nil
+    })
+    return f
+}
+
+// isIMONumber reports whether the seven digits of number end with the
+// IMO check digit.
+func isIMONumber(number string) bool {
+    sum := 0
+    for i := 0; i < 6; i++ {
+        sum += int(number[i]-'0') * (7 - i)
+    }
+    return sum%10 == int(number[6]-'0')
+}
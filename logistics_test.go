@@ -0,0 +1,62 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestContainerCode(t *testing.T) {
+    tests := []struct {
+        value string
+        code  string
+    }{
+        // Published codes, then the same with only the check digit changed.
+        {"CSQU3054383", "CSQU3054383"},
+        {"MSKU9070323", "MSKU9070323"},
+        {"MSCU1234566", "MSCU1234566"},
+        {"csqu 305438-3", "CSQU3054383"},
+        {"CSQU3054384", ""},
+        {"MSKU9070324", ""},
+        {"MSCU1234565", ""},
+
+        {"CSQX3054383", ""},
+        {"CSQU305438", ""},
+        {"", ""},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        f := v.Field(tt.value, "Container").ContainerCode()
+        valid := v.Validate(false) == nil
+        if valid != (tt.code != "") {
+            t.Errorf("ContainerCode(%q): valid = %v, want %v", tt.value, valid, tt.code != "")
+        } else if valid && f.Value() != tt.code {
+            t.Errorf("ContainerCode(%q): value %q, want %q", tt.value, f.Value(), tt.code)
+        }
+    }
+}
+
+func TestIMONumber(t *testing.T) {
+    tests := []struct {
+        value  string
+        number string
+    }{
+        {"9074729", "IMO 9074729"},
+        {"IMO 9074729", "IMO 9074729"},
+        {"imo9176187", "IMO 9176187"},
+        {"9074728", ""},
+        {"IMO 9176188", ""},
+        {"907472", ""},
+        {"IMO 90747290", ""},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        f := v.Field(tt.value, "IMO Number").IMONumber()
+        valid := v.Validate(false) == nil
+        if valid != (tt.number != "") {
+            t.Errorf("IMONumber(%q): valid = %v, want %v", tt.value, valid, tt.number != "")
+        } else if valid && f.Value() != tt.number {
+            t.Errorf("IMONumber(%q): value %q, want %q", tt.value, f.Value(), tt.number)
+        }
+    }
+}
@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+)
+
+// LookupError reports that a lookup callback, such as the one passed to
+// UniqueBy or ExistsBy, returned an error. It means the check could not be
+// performed (the database was down, a service timed out) and must not be
+// shown to the user as a problem with their input.
+type LookupError struct {
+    Field string
+    Rule  string
+    Err   error
+}
+
+// Error implements the error interface.
+func (e *LookupError) Error() string {
+    return fmt.Sprintf("validator: %s lookup for %s failed: %v", e.Rule, e.Field, e.Err)
+}
+
+// Unwrap returns the error returned by the lookup callback.
+func (e *LookupError) Unwrap() error {
+    return e.Err
+}
+
+// UniqueBy validates that the value is not already in use, by asking
+// `lookup`, which reports whether the value is unique. It is called with
+// the context passed to ValidateContext.
+//
+// A false result fails the field. A non-nil error from the lookup is not a
+// field error: validation stops and ValidateContext returns it wrapped in
+// a *LookupError.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.UniqueBy(func(ctx context.Context, value interface{}) (bool, error) {
+//        taken, err := users.EmailExists(ctx, value.(string))
+//        return !taken, err
+//    }, "Email is already taken")
+func (f *Field) UniqueBy(lookup func(ctx context.Context, value interface{}) (bool, error), messages ...string) *Field {
+    f.addRule("uniqueBy", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        unique, err := lookup(f.validator.context(), f.value)
+        if err != nil {
+            return &LookupError{Field: f.name, Rule: "uniqueBy", Err: err}
+        }
+
+        if !unique {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s is already taken", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// ExistsBy validates that the value refers to something that exists, by
+// asking `lookup`, which reports whether it was found. It is called with
+// the context passed to ValidateContext.
+//
+// A false result fails the field. A non-nil error from the lookup is not a
+// field error: validation stops and ValidateContext returns it wrapped in
+// a *LookupError.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ExistsBy(func(ctx context.Context, value interface{}) (bool, error) {
+//        return categories.Exists(ctx, value.(string))
+//    })
+func (f *Field) ExistsBy(lookup func(ctx context.Context, value interface{}) (bool, error), messages ...string) *Field {
+    f.addRule("existsBy", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        found, err := lookup(f.validator.context(), f.value)
+        if err != nil {
+            return &LookupError{Field: f.name, Rule: "existsBy", Err: err}
+        }
+
+        if !found {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s does not exist", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// context returns the context of the current validation run.
+func (v *Validator) context() context.Context {
+    if v == nil || v.ctx == nil {
+        return context.Background()
+    }
+    return v.ctx
+}
@@ -0,0 +1,111 @@
+package validator
+
+import (
+    "context"
+    "errors"
+    "testing"
+)
+
+var errLookupDown = errors.New("database is down")
+
+// lookupReturning returns a lookup callback that reports `ok` and `err`.
+func lookupReturning(ok bool, err error) func(ctx context.Context, value interface{}) (bool, error) {
+    return func(ctx context.Context, value interface{}) (bool, error) {
+        return ok, err
+    }
+}
+
+func TestLookupFalseIsFieldError(t *testing.T) {
+    tests := []struct {
+        rules   func(f *Field)
+        message string
+    }{
+        {func(f *Field) { f.UniqueBy(lookupReturning(false, nil)) }, "Email is already taken"},
+        {func(f *Field) { f.UniqueBy(lookupReturning(false, nil), "Pick another email") }, "Pick another email"},
+        {func(f *Field) { f.ExistsBy(lookupReturning(false, nil)) }, "Email does not exist"},
+        {func(f *Field) { f.UniqueBy(lookupReturning(true, nil)) }, ""},
+        {func(f *Field) { f.ExistsBy(lookupReturning(true, nil)) }, ""},
+    }
+
+    for i, tt := range tests {
+        v := New()
+        tt.rules(v.Field("ada@example.com", "Email"))
+        errs, err := v.ValidateContext(context.Background(), false)
+        if err != nil {
+            t.Errorf("case %d: unexpected error %v", i, err)
+            continue
+        }
+
+        got := ""
+        if len(errs) > 0 {
+            got = errs[0].Error()
+        }
+        if got != tt.message {
+            t.Errorf("case %d: got %q, want %q", i, got, tt.message)
+        }
+    }
+}
+
+func TestLookupErrorFromValidateContext(t *testing.T) {
+    for _, rule := range []string{"uniqueBy", "existsBy"} {
+        v := New()
+        v.Field("", "Name").Required()
+        f := v.Field("ada@example.com", "Email")
+        if rule == "uniqueBy" {
+            f.UniqueBy(lookupReturning(false, errLookupDown))
+        } else {
+            f.ExistsBy(lookupReturning(false, errLookupDown))
+        }
+
+        errs, err := v.ValidateContext(context.Background(), false)
+        if errs != nil {
+            t.Errorf("%s: field errors %v, want nil", rule, errs)
+        }
+
+        var lookupErr *LookupError
+        if !errors.As(err, &lookupErr) {
+            t.Fatalf("%s: got %v, want a *LookupError", rule, err)
+        }
+        if lookupErr.Field != "Email" || lookupErr.Rule != rule || !errors.Is(err, errLookupDown) {
+            t.Errorf("%s: got %+v", rule, lookupErr)
+        }
+    }
+}
+
+func TestLookupErrorLastFromValidate(t *testing.T) {
+    v := New()
+    v.Field("", "Name").Required()
+    v.Field("ada@example.com", "Email").UniqueBy(lookupReturning(false, errLookupDown))
+    v.Field("", "City").Required()
+
+    errs := v.Validate(false)
+    if len(errs) != 2 {
+        t.Fatalf("got %d errors, want the Name error and the lookup error: %v", len(errs), errs)
+    }
+    if got, want := errs[0].Error(), "Name is required"; got != want {
+        t.Errorf("first error %q, want %q", got, want)
+    }
+    var lookupErr *LookupError
+    if !errors.As(errs[1], &lookupErr) {
+        t.Errorf("last error %v is not a *LookupError", errs[1])
+    }
+}
+
+func TestLookupReceivesContext(t *testing.T) {
+    type key struct{}
+    ctx := context.WithValue(context.Background(), key{}, "request")
+
+    var got interface{}
+    v := New()
+    v.Field("ada@example.com", "Email").ExistsBy(func(ctx context.Context, value interface{}) (bool, error) {
+        got = ctx.Value(key{})
+        return true, nil
+    })
+
+    if _, err := v.ValidateContext(ctx, false); err != nil {
+        t.Fatal(err)
+    }
+    if got != "request" {
+        t.Errorf("the lookup got context value %v, want %q", got, "request")
+    }
+}
@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidateMap validates a decoded JSON document such as the result of
+// json.Unmarshal into a map[string]interface{}.
+//
+// Each key in `rules` is a dotted path into the document:
+//   - "address.city" reads the "city" key of the "address" object
+//   - "items.0.sku" reads the "sku" key of the first element of "items"
+//   - "items.*.sku" applies the rules to the "sku" key of every element
+//
+// Errors use the full path as the field name, e.g. "items.2.sku is required".
+// A missing path fails Required and is skipped by Optional. When a segment
+// along the path has the wrong type (e.g. "address" is a string), a single
+// error is reported for the path instead. Paths are checked in sorted order
+// so the errors are deterministic.
+//
+// Example:
+//
+//    errs := validator.ValidateMap(payload, map[string]func(f *validator.Field){
+//        "email":       func(f *validator.Field) { f.Required().Email() },
+//        "address.zip": func(f *validator.Field) { f.Optional().MinLength(5) },
+//        "items.*.sku": func(f *validator.Field) { f.Required().String() },
+//    })
+func ValidateMap(data map[string]interface{}, rules map[string]func(f *Field)) []error {
+    paths := make([]string, 0, len(rules))
+    for path := range rules {
+        paths = append(paths, path)
+    }
+    sort.Strings(paths)
+
+    s := NewSchema(nil)
+    for _, path := range paths {
+        f := s.Field(path)
+        if build := rules[path]; build != nil {
+            build(f)
+        }
+    }
+    return s.ValidateMap(data, false)
+}
+
+// pathValue is a value found at a concrete path in a document.
+type pathValue struct {
+    path   string
+    value  interface{}
+    absent bool
+    err    error
+}
+
+// lookupPath resolves a dotted path against a document, expanding
+// "*" segments into one result per element.
+func lookupPath(data interface{}, path string) []pathValue {
+    return walkPath(data, strings.Split(path, "."), "")
+}
+
+func walkPath(current interface{}, segments []string, prefix string) []pathValue {
+    if len(segments) == 0 {
+        return []pathValue{{path: prefix, value: current}}
+    }
+
+    segment := segments[0]
+    path := joinPath(prefix, segment)
+
+    if current == nil {
+        return []pathValue{{path: joinPath(path, segments[1:]...), absent: true}}
+    }
+
+    rv := reflect.ValueOf(current)
+    switch rv.Kind() {
+    case reflect.Map:
+        if rv.Type().Key().Kind() != reflect.String {
+            break
+        }
+
+        if segment == "*" {
+            keys := rv.MapKeys()
+            sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+            var results []pathValue
+            for _, key := range keys {
+                results = append(results, walkPath(rv.MapIndex(key).Interface(), segments[1:], joinPath(prefix, key.String()))...)
+            }
+            return results
+        }
+
+        elem := rv.MapIndex(reflect.ValueOf(segment).Convert(rv.Type().Key()))
+        if !elem.IsValid() {
+            return []pathValue{{path: joinPath(path, segments[1:]...), absent: true}}
+        }
+        return walkPath(elem.Interface(), segments[1:], path)
+
+    case reflect.Slice, reflect.Array:
+        if segment == "*" {
+            var results []pathValue
+            for i := 0; i < rv.Len(); i++ {
+                results = append(results, walkPath(rv.Index(i).Interface(), segments[1:], joinPath(prefix, strconv.Itoa(i)))...)
+            }
+            return results
+        }
+
+        index, err := strconv.Atoi(segment)
+        if err != nil || index < 0 {
+            return []pathValue{{
+                path: joinPath(path, segments[1:]...),
+                err:  fmt.Errorf("%s cannot be read: %s is a list and %q is not an index", joinPath(path, segments[1:]...), displayPath(prefix), segment),
+            }}
+        }
+        if index >= rv.Len() {
+            return []pathValue{{path: joinPath(path, segments[1:]...), absent: true}}
+        }
+        return walkPath(rv.Index(index).Interface(), segments[1:], path)
+    }
+
+    full := joinPath(path, segments[1:]...)
+    return []pathValue{{
+        path: full,
+        err:  fmt.Errorf("%s cannot be read: %s is not an object or list", full, displayPath(prefix)),
+    }}
+}
+
+// joinPath appends segments to a dotted path.
+func joinPath(prefix string, segments ...string) string {
+    parts := segments
+    if prefix != "" {
+        parts = append([]string{prefix}, segments...)
+    }
+    return strings.Join(parts, ".")
+}
+
+// displayPath names the document root when the path is empty.
+func displayPath(path string) string {
+    if path == "" {
+        return "document"
+    }
+    return path
+}
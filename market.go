@@ -0,0 +1,130 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+// TickerOption relaxes the checks of TickerWith.
+// Options can be combined with |.
+type TickerOption int
+
+const (
+    // TickerAllowSuffix accepts a market suffix of 1 to 4 letters after a
+    // dot, such as "VOD.L" or "SHOP.TO".
+    TickerAllowSuffix TickerOption = 1 << iota
+    // TickerAllowExchange accepts an exchange code of 1 to 10 letters or
+    // digits after a colon, such as "AAPL:NASDAQ".
+    TickerAllowExchange
+)
+
+var (
+    tickerSymbolPattern   = regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]+)?$`)
+    tickerSuffixPattern   = regexp.MustCompile(`^[A-Z]{1,4}$`)
+    tickerExchangePattern = regexp.MustCompile(`^[A-Z0-9]{1,10}$`)
+)
+
+// CurrencyPair validates that the value is a currency pair such as
+// "USD/NGN": two different active ISO 4217 codes in upper case, the base
+// and the quote currency, joined by `sep`. An empty sep expects the codes
+// written together, as in "USDNGN". The error names the side that is not a
+// known currency.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.CurrencyPair("/") // "USD/NGN"; not "USD/USD" or "USD/XYZ"
+//    f.CurrencyPair("-", "Pair must look like EUR-USD")
+func (f *Field) CurrencyPair(sep string, messages ...string) *Field {
+    f.addRule("currencyPair", []interface{}{sep}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var err error
+        switch {
+        case !ok || len(str) != 6+len(sep) || str[3:3+len(sep)] != sep:
+            err = fmt.Errorf("%s must be two currency codes separated by %q", f.name, sep)
+            if sep == "" {
+                err = fmt.Errorf("%s must be two currency codes, such as USDNGN", f.name)
+            }
+        case !isCurrencyCode(str[:3]):
+            err = fmt.Errorf("%s has an invalid base currency %q", f.name, str[:3])
+        case !isCurrencyCode(str[3+len(sep):]):
+            err = fmt.Errorf("%s has an invalid quote currency %q", f.name, str[3+len(sep):])
+        case str[:3] == str[3+len(sep):]:
+            err = fmt.Errorf("%s must have different base and quote currencies", f.name)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// isCurrencyCode reports whether code is an active ISO 4217 code.
+func isCurrencyCode(code string) bool {
+    _, known := currencyMinorUnits[code]
+    return known
+}
+
+// Ticker validates that the value is a ticker symbol: 1 to 10 upper case
+// letters and digits, optionally in two parts joined by a hyphen, such as
+// "AAPL", "BRK-B" or "BTC-USD". Use TickerWith to accept exchange suffixes.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Ticker()
+//    f.Ticker("Unknown symbol format")
+func (f *Field) Ticker(messages ...string) *Field {
+    return f.TickerWith(0, messages...)
+}
+
+// TickerWith is Ticker with options, such as TickerAllowSuffix for "VOD.L"
+// or TickerAllowExchange for "AAPL:NASDAQ". The symbol itself is checked
+// as by Ticker.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.TickerWith(validator.TickerAllowSuffix | validator.TickerAllowExchange)
+func (f *Field) TickerWith(opts TickerOption, messages ...string) *Field {
+    var params []interface{}
+    if opts != 0 {
+        params = []interface{}{opts}
+    }
+
+    f.addRule("ticker", params, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok && opts&TickerAllowExchange != 0 {
+            if symbol, exchange, found := strings.Cut(str, ":"); found {
+                str = symbol
+                ok = tickerExchangePattern.MatchString(exchange)
+            }
+        }
+        if ok && opts&TickerAllowSuffix != 0 {
+            if dot := strings.LastIndex(str, "."); dot >= 0 {
+                ok = tickerSuffixPattern.MatchString(str[dot+1:])
+                str = str[:dot]
+            }
+        }
+        ok = ok && len(str) <= 10 && tickerSymbolPattern.MatchString(str)
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid ticker symbol", f.name)
+        }
+        return nil
+    })
+    return f
+}
@@ -0,0 +1,97 @@
+package validator
+
+import (
+    "context"
+    "slices"
+)
+
+// lastRun is the result of the most recent validation run of a validator
+// and the error limit it ran with.
+type lastRun struct {
+    result *Result
+    limit  int
+}
+
+// Validated reports whether v was validated since fields, rules or options
+// last changed, so LastResult holds the current result.
+//
+// Example:
+//
+//    if !v.Validated() {
+//        v.Validate(false)
+//    }
+func (v *Validator) Validated() bool {
+    return v.root().last != nil
+}
+
+// LastResult returns the result of the most recent run of Validate,
+// ValidateContext or ValidateN, or nil when v wasn't validated since its
+// fields, rules or options last changed. It lets a handler render the
+// errors found by a middleware without validating again.
+//
+// Example:
+//
+//    if res := v.LastResult(); res != nil && !res.Valid() {
+//        render(w, res.ErrorsMap())
+//    }
+func (v *Validator) LastResult() *Result {
+    if last := v.root().last; last != nil {
+        return last.result
+    }
+    return nil
+}
+
+// Invalidate forgets the result of the last run, so the next Validate
+// checks every rule again, such as after a value read by a Custom rule
+// changed. Registering fields or rules, changing options and calling Set
+// do this automatically.
+func (v *Validator) Invalidate() *Validator {
+    v.root().last = nil
+    return v
+}
+
+// changed forgets the result of the last run of the validator of f, if it
+// was registered on one.
+func (f *Field) changed() {
+    if f.validator != nil {
+        f.validator.Invalidate()
+    }
+}
+
+// runCached returns the result of the last run when it can be reused for
+// a run limited to `limit` errors, and runs the rules otherwise.
+//
+// A result is reused when nothing changed since, the limit is the same and
+// the run didn't abort. Validators with lazily evaluated fields (FieldFunc),
+// lookup rules (UniqueBy, ExistsBy) or computed checks (CheckComputed)
+// always run again, since their outcome may change between runs. Custom rules are assumed to depend only
+// on the value; call Invalidate when one reads something else that changed.
+// A reused result doesn't call the OnRuleResult and OnValidateDone hooks.
+func (v *Validator) runCached(ctx context.Context, limit int) *Result {
+    owner := v.root()
+    if last := owner.last; last != nil && last.limit == limit && last.result.err == nil && owner.repeatable() {
+        return last.result
+    }
+
+    res := v.run(ctx, limit)
+    res.errors = slices.Clip(res.errors)
+    owner.last = &lastRun{result: res, limit: limit}
+    return res
+}
+
+// repeatable reports whether running v again gives the same result, which
+// is not the case with lazily evaluated fields, lookup rules or computed
+// checks.
+func (v *Validator) repeatable() bool {
+    for _, f := range v.fields {
+        if f.supplier != nil {
+            return false
+        }
+        for _, r := range f.rules {
+            if r.name == "uniqueBy" || r.name == "existsBy" || r.name == "computed" {
+                return false
+            }
+        }
+    }
+    return true
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestInvalidateClearsLastResult(t *testing.T) {
+    calls := 0
+    available := true
+    v := New()
+    v.Field("ada", "Username").Custom(func(value interface{}) error {
+        calls++
+        if !available {
+            return errors.New("Username is taken")
+        }
+        return nil
+    })
+
+    first := v.ValidateN(0)
+    if again := v.ValidateN(0); again != first || calls != 1 {
+        t.Fatalf("the second run was not served from the cache: %d calls", calls)
+    }
+
+    available = false
+    v.Invalidate()
+    if v.Validated() || v.LastResult() != nil {
+        t.Error("Invalidate kept the last result")
+    }
+    if res := v.ValidateN(0); res.Valid() || calls != 2 {
+        t.Errorf("after Invalidate: valid = %v after %d calls, want the rule to run again", res.Valid(), calls)
+    }
+}
+
+func BenchmarkValidateCached(b *testing.B) {
+    build := func() *Validator {
+        v := New()
+        v.Field("ada@example.com", "Email").Required().Email()
+        v.Field("Ada Lovelace", "Name").Required().MinRunes(2).MaxRunes(64)
+        v.Field(36, "Age").Integer().Min(18).Max(120)
+        return v
+    }
+
+    b.Run("uncached", func(b *testing.B) {
+        v := build()
+        b.ReportAllocs()
+        for b.Loop() {
+            v.Invalidate()
+            v.Validate(false)
+        }
+    })
+    b.Run("cached", func(b *testing.B) {
+        v := build()
+        b.ReportAllocs()
+        for b.Loop() {
+            v.Validate(false)
+        }
+    })
+}
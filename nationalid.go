@@ -0,0 +1,123 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+var (
+    ssnPattern        = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+    nigerianIDPattern = regexp.MustCompile(`^\d{11}$`)
+)
+
+// nationalIDCheckers maps ISO 3166-1 alpha-2 country codes to the
+// check used by NationalID for that country.
+var nationalIDCheckers = map[string]func(string) bool{
+    "NG": isNigerianID,
+    "US": isSSN,
+}
+
+// SSN validates that the value is a US Social Security number, written as
+// "123-45-6789" or "123456789". Numbers that are never issued are
+// rejected: area 000, 666 or 900–999, group 00 and serial 0000.
+// The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.SSN()
+//    f.SSN("Enter a valid Social Security number")
+func (f *Field) SSN(messages ...string) *Field {
+    f.addRule("ssn", nil, func(f *Field) error {
+        return checkNationalID(f, messages, isSSN, "must be a valid Social Security number")
+    })
+    return f
+}
+
+// NIN validates that the value is a Nigerian National Identification
+// Number: 11 digits. NIMC publishes no checksum, so only the structure is
+// checked. The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NIN()
+func (f *Field) NIN(messages ...string) *Field {
+    f.addRule("nin", nil, func(f *Field) error {
+        return checkNationalID(f, messages, isNigerianID, "must be a valid NIN")
+    })
+    return f
+}
+
+// BVN validates that the value is a Nigerian Bank Verification Number:
+// 11 digits. No checksum is published, so only the structure is checked.
+// The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.BVN()
+func (f *Field) BVN(messages ...string) *Field {
+    f.addRule("bvn", nil, func(f *Field) error {
+        return checkNationalID(f, messages, isNigerianID, "must be a valid BVN")
+    })
+    return f
+}
+
+// NationalID validates that the value is a national identification number
+// of `country`, an ISO 3166-1 alpha-2 code: "US" checks a Social Security
+// number and "NG" a NIN. An unsupported country is a *ConfigError (see
+// Validator.Err). The error does not repeat the number.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NationalID(user.Country)
+//    f.NationalID("NG", "Enter your 11-digit NIN")
+func (f *Field) NationalID(country string, messages ...string) *Field {
+    check, ok := nationalIDCheckers[strings.ToUpper(country)]
+    f.addRule("nationalID", []interface{}{country}, func(f *Field) error {
+        return checkNationalID(f, messages, check, "must be a valid national ID")
+    })
+
+    var err error
+    if !ok {
+        err = fmt.Errorf("national IDs of %q are not supported", country)
+    }
+    return f.misconfigured(err)
+}
+
+// checkNationalID checks the value of f with `check`, returning the custom
+// message or the field name followed by `format` on failure.
+func checkNationalID(f *Field, messages []string, check func(string) bool, format string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, ok := f.value.(string)
+    if !ok || !check(str) {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s %s", f.name, format)
+    }
+    return nil
+}
+
+// isSSN reports whether str is a Social Security number that can be issued.
+func isSSN(str string) bool {
+    match := ssnPattern.FindStringSubmatch(str)
+    if match == nil {
+        return false
+    }
+    // Dashes must be both present or both absent.
+    if len(str) != 9 && len(str) != 11 {
+        return false
+    }
+
+    area, group, serial := match[1], match[2], match[3]
+    return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// isNigerianID reports whether str has the structure of a NIN or BVN.
+func isNigerianID(str string) bool {
+    return nigerianIDPattern.MatchString(str)
+}
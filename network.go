@@ -0,0 +1,167 @@
+package validator
+
+import (
+    "fmt"
+    "net"
+    "net/netip"
+    "net/url"
+    "regexp"
+    "strings"
+)
+
+// nonPublicPrefixes lists the special-purpose ranges that netip.Addr has
+// no predicate for.
+var nonPublicPrefixes = []netip.Prefix{
+    netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+    netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+    netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+    netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+    netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+    netip.MustParsePrefix("198.51.100.0/24"), // documentation
+    netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+    netip.MustParsePrefix("240.0.0.0/4"),     // reserved, and broadcast
+    netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, can embed private IPv4
+    netip.MustParsePrefix("100::/64"),        // discard
+    netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+// numericHostPattern matches host names made only of decimal, octal or hex
+// numbers, such as "2130706433" or "0x7f.1", which some resolvers treat as
+// IPv4 addresses.
+var numericHostPattern = regexp.MustCompile(`(?i)^(0x[0-9a-f]*|[0-9]+)(\.(0x[0-9a-f]*|[0-9]+))*\.?$`)
+
+// PublicIP validates that the value is an IP address reachable on the
+// public internet. It fails for unspecified, loopback, private (RFC 1918),
+// link-local (169.254.0.0/16, fe80::/10), carrier-grade NAT (100.64.0.0/10),
+// unique local IPv6 (fc00::/7), multicast, documentation and other reserved
+// ranges; IPv4-mapped IPv6 addresses are checked as IPv4. The value may be
+// a string, a net.IP or a netip.Addr.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PublicIP()
+//    f.PublicIP("Address must be publicly reachable")
+func (f *Field) PublicIP(messages ...string) *Field {
+    f.addRule("publicIP", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        addr, ok := ipValue(f.value)
+        if !ok || !isPublicIP(addr) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a public IP address", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// PrivateIP validates that the value is an IP address that PublicIP
+// rejects, such as a loopback, private or link-local address.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PrivateIP()
+func (f *Field) PrivateIP(messages ...string) *Field {
+    f.addRule("privateIP", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        addr, ok := ipValue(f.value)
+        if !ok || isPublicIP(addr) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a private IP address", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// PublicURL validates that the value is an http or https URL that does not
+// point at a non-public address, to guard webhook targets against SSRF.
+// IP-literal hosts must pass PublicIP; "localhost", names under
+// ".localhost" and numeric hosts such as "2130706433" are rejected. Other
+// host names are accepted without a DNS lookup, so a name that resolves to
+// a private address still passes: check the resolved address again when
+// connecting.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PublicURL()
+//    f.PublicURL("Webhook URL must be publicly reachable")
+func (f *Field) PublicURL(messages ...string) *Field {
+    f.addRule("publicURL", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !isPublicURL(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a public URL", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// ipValue returns value as an IP address, accepting strings (with an
+// optional IPv6 zone), net.IP and netip.Addr.
+func ipValue(value interface{}) (netip.Addr, bool) {
+    switch v := value.(type) {
+    case string:
+        addr, err := netip.ParseAddr(v)
+        return addr, err == nil
+    case net.IP:
+        return netip.AddrFromSlice(v)
+    case netip.Addr:
+        return v, v.IsValid()
+    }
+    return netip.Addr{}, false
+}
+
+// isPublicIP reports whether addr is outside every non-public range.
+func isPublicIP(addr netip.Addr) bool {
+    addr = addr.Unmap()
+    if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+        return false
+    }
+
+    for _, prefix := range nonPublicPrefixes {
+        if prefix.Contains(addr.WithZone("")) {
+            return false
+        }
+    }
+    return true
+}
+
+// isPublicURL reports whether str is an http(s) URL whose host is a
+// public IP literal or a host name that isn't local.
+func isPublicURL(str string) bool {
+    u, err := url.Parse(str)
+    if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+        return false
+    }
+
+    host := strings.ToLower(u.Hostname())
+    if host == "" {
+        return false
+    }
+    if addr, err := netip.ParseAddr(host); err == nil {
+        return isPublicIP(addr)
+    }
+
+    host = strings.TrimSuffix(host, ".")
+    return host != "localhost" && !strings.HasSuffix(host, ".localhost") && !numericHostPattern.MatchString(host)
+}
@@ -0,0 +1,91 @@
+package validator
+
+import (
+    "net"
+    "net/netip"
+    "testing"
+)
+
+func TestPublicIP(t *testing.T) {
+    tests := []struct {
+        value  interface{}
+        public bool
+    }{
+        {"8.8.8.8", true},
+        {"2001:4860:4860::8888", true},
+        {"100.63.255.255", true},
+        {"100.128.0.0", true},
+        {net.ParseIP("1.1.1.1"), true},
+        {netip.MustParseAddr("9.9.9.9"), true},
+
+        {"169.254.169.254", false},
+        {"169.254.0.1", false},
+        {"::1", false},
+        {"127.0.0.1", false},
+        {"fd00::1", false},
+        {"fdff:ffff::1", false},
+        {"100.64.0.0", false},
+        {"100.127.255.255", false},
+        {"10.0.0.1", false},
+        {"172.16.0.1", false},
+        {"192.168.1.1", false},
+        {"fe80::1%eth0", false},
+        {"224.0.0.1", false},
+        {"ff02::1", false},
+        {"::ffff:10.0.0.1", false},
+        {"0.0.0.0", false},
+    }
+
+    for _, tt := range tests {
+        if public := passes(tt.value, func(f *Field) { f.PublicIP() }); public != tt.public {
+            t.Errorf("PublicIP(%v): valid = %v, want %v", tt.value, public, tt.public)
+        }
+    }
+}
+
+func TestPrivateIP(t *testing.T) {
+    tests := []struct {
+        value   string
+        private bool
+    }{
+        {"10.0.0.1", true},
+        {"100.64.0.1", true},
+        {"fd00::1", true},
+        {"::1", true},
+        {"8.8.8.8", false},
+        {"not an ip", false},
+    }
+
+    for _, tt := range tests {
+        if private := passes(tt.value, func(f *Field) { f.PrivateIP() }); private != tt.private {
+            t.Errorf("PrivateIP(%q): valid = %v, want %v", tt.value, private, tt.private)
+        }
+    }
+}
+
+func TestPublicURL(t *testing.T) {
+    tests := []struct {
+        value  string
+        public bool
+    }{
+        {"https://hooks.example.com/events", true},
+        {"http://8.8.8.8:8080/hook", true},
+        {"https://[2001:4860:4860::8888]/hook", true},
+
+        {"http://169.254.169.254/latest/meta-data", false},
+        {"http://[::1]/hook", false},
+        {"http://[fd00::1]/hook", false},
+        {"http://100.64.0.1/hook", false},
+        {"http://localhost:8080/hook", false},
+        {"http://api.localhost/hook", false},
+        {"http://2130706433/hook", false},
+        {"ftp://8.8.8.8/hook", false},
+        {"hooks.example.com/events", false},
+    }
+
+    for _, tt := range tests {
+        if public := passes(tt.value, func(f *Field) { f.PublicURL() }); public != tt.public {
+            t.Errorf("PublicURL(%q): valid = %v, want %v", tt.value, public, tt.public)
+        }
+    }
+}
@@ -0,0 +1,188 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+    "unicode/utf8"
+)
+
+// Hangul syllables are composed and decomposed algorithmically rather
+// than through the tables in normalize_tables.go.
+const (
+    hangulBase   = 0xAC00
+    hangulLBase  = 0x1100
+    hangulVBase  = 0x1161
+    hangulTBase  = 0x11A7
+    hangulLCount = 19
+    hangulVCount = 21
+    hangulTCount = 28
+    hangulNCount = hangulVCount * hangulTCount
+    hangulCount  = hangulLCount * hangulNCount
+)
+
+// Normalize rewrites a string value into the Unicode normalization `form`
+// ("NFC", "NFD", "NFKC" or "NFKD"; an empty form means "NFC") before the
+// rules registered after it run. Visually identical strings such as "café"
+// typed with a precomposed "é" or with "e" plus a combining accent then
+// compare equal, so uniqueness checks can't be bypassed, and the normalized
+// string is what Value returns for storage.
+//
+// MinLength and MaxLength count bytes of the working value, so they only see
+// the normalized string when they are registered after Normalize: a
+// decomposed "café" is 6 bytes, its NFC form is 5. Non-string values are left
+// unchanged for String to report. An unknown form is a *ConfigError (see
+// Validator.Err).
+//
+// Example:
+//    f.Normalize("NFC").MinLength(2).MaxLength(50)
+//    f.Normalize("NFKC") // also folds compatibility characters such as "ﬁ"
+func (f *Field) Normalize(form string) *Field {
+    if form == "" {
+        form = "NFC"
+    }
+
+    compose, compat, ok := normalizationForm(form)
+    var formErr error
+    if !ok {
+        formErr = fmt.Errorf("unsupported normalization form %q", form)
+    }
+
+    f.addRule("normalize", []interface{}{form}, func(f *Field) error {
+        if str, isString := f.value.(string); isString {
+            f.value = normalizeString(str, compose, compat)
+        }
+        return nil
+    })
+    return f.misconfigured(formErr)
+}
+
+// normalizationForm reports whether `form` names a Unicode normalization
+// form, and if so whether it composes and whether it applies compatibility
+// decompositions.
+func normalizationForm(form string) (compose, compat, ok bool) {
+    switch strings.ToUpper(form) {
+    case "NFC":
+        return true, false, true
+    case "NFD":
+        return false, false, true
+    case "NFKC":
+        return true, true, true
+    case "NFKD":
+        return false, true, true
+    }
+    return false, false, false
+}
+
+// normalizeString returns `str` decomposed, canonically reordered and, if
+// `compose` is set, recomposed. ASCII text is returned as is, since it is
+// already in every normalization form.
+func normalizeString(str string, compose, compat bool) string {
+    ascii := true
+    for i := 0; i < len(str); i++ {
+        if str[i] >= utf8.RuneSelf {
+            ascii = false
+            break
+        }
+    }
+    if ascii {
+        return str
+    }
+
+    runes := decompose(str, compat)
+    if compose {
+        runes = composeRunes(runes)
+    }
+    return string(runes)
+}
+
+// decompose returns the full canonical (or, with `compat`, compatibility)
+// decomposition of `str` in canonical order.
+func decompose(str string, compat bool) []rune {
+    runes := make([]rune, 0, len(str))
+    for _, r := range str {
+        if r >= hangulBase && r < hangulBase+hangulCount {
+            index := r - hangulBase
+            runes = append(runes, hangulLBase+index/hangulNCount, hangulVBase+(index%hangulNCount)/hangulTCount)
+            if t := index % hangulTCount; t != 0 {
+                runes = append(runes, hangulTBase+t)
+            }
+            continue
+        }
+
+        if compat {
+            if d, ok := compatibilityDecompositions[r]; ok {
+                runes = append(runes, []rune(d)...)
+                continue
+            }
+        }
+        if d, ok := canonicalDecompositions[r]; ok {
+            runes = append(runes, []rune(d)...)
+            continue
+        }
+        runes = append(runes, r)
+    }
+
+    // Sort each run of combining marks by combining class, keeping
+    // marks of the same class in their original order.
+    for i := 1; i < len(runes); i++ {
+        class := combiningClasses[runes[i]]
+        if class == 0 {
+            continue
+        }
+        for j := i; j > 0; j-- {
+            prev := combiningClasses[runes[j-1]]
+            if prev == 0 || prev <= class {
+                break
+            }
+            runes[j-1], runes[j] = runes[j], runes[j-1]
+        }
+    }
+    return runes
+}
+
+// composeRunes applies canonical composition to decomposed `runes`,
+// combining each mark with the preceding starter unless another mark of
+// the same or a higher combining class sits between them.
+func composeRunes(runes []rune) []rune {
+    if len(runes) == 0 {
+        return runes
+    }
+
+    out := runes[:1]
+    starter := 0
+    lastClass := combiningClasses[runes[0]]
+    if lastClass != 0 {
+        // A leading mark has no starter to combine with.
+        starter = -1
+    }
+
+    for _, r := range runes[1:] {
+        class := combiningClasses[r]
+        if starter >= 0 && (lastClass < class || lastClass == 0) {
+            if composite, ok := composePair(out[starter], r); ok {
+                out[starter] = composite
+                continue
+            }
+        }
+        if class == 0 {
+            starter = len(out)
+        }
+        lastClass = class
+        out = append(out, r)
+    }
+    return out
+}
+
+// composePair returns the primary composite of `a` followed by `b`.
+func composePair(a, b rune) (rune, bool) {
+    if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+        return hangulBase + ((a-hangulLBase)*hangulVCount+(b-hangulVBase))*hangulTCount, true
+    }
+    if a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTCount == 0 &&
+        b > hangulTBase && b < hangulTBase+hangulTCount {
+        return a + (b - hangulTBase), true
+    }
+
+    composite, ok := primaryComposites[[2]rune{a, b}]
+    return composite, ok
+}
@@ -0,0 +1,94 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestNormalizeString(t *testing.T) {
+    tests := []struct {
+        form, value, want string
+    }{
+        {"NFC", "cafe\u0301", "caf\u00e9"},
+        {"NFC", "caf\u00e9", "caf\u00e9"},
+        {"NFD", "caf\u00e9", "cafe\u0301"},
+        {"NFC", "\u212B", "\u00C5"},
+        {"NFD", "\u00C5", "A\u030A"},
+
+        // Combining marks are put in canonical order, and a mark only
+        // composes with the base when no mark of the same class is in
+        // between.
+        {"NFD", "a\u0301\u0323", "a\u0323\u0301"},
+        {"NFC", "a\u0301\u0323", "\u1EA1\u0301"},
+        {"NFC", "a\u0301\u0301", "\u00E1\u0301"},
+
+        // Composition exclusions stay decomposed.
+        {"NFC", "\u0958", "\u0915\u093C"},
+
+        // Compatibility characters are only folded by NFKC and NFKD.
+        {"NFC", "\uFB01", "\uFB01"},
+        {"NFKC", "\uFB01", "fi"},
+        {"NFKC", "\u2460", "1"},
+        {"NFKC", "x\u00B2", "x2"},
+        {"NFKC", "\uFF76", "\u30AB"},
+        {"NFKD", "\u00BD", "1\u20442"},
+        {"NFKC", "\u1E9B\u0323", "\u1E69"},
+        {"NFKD", "\u1E9B\u0323", "s\u0323\u0307"},
+
+        // Hangul syllables are composed and decomposed algorithmically.
+        {"NFD", "\uAC01", "\u1100\u1161\u11A8"},
+        {"NFC", "\u1100\u1161\u11A8", "\uAC01"},
+        {"NFC", "\u1100\u1161", "\uAC00"},
+        {"NFC", "\uAC00\u11A8", "\uAC01"},
+        {"NFD", "\uD55C", "\u1112\u1161\u11AB"},
+
+        {"NFC", "plain ascii", "plain ascii"},
+    }
+
+    for _, tt := range tests {
+        compose, compat, _ := normalizationForm(tt.form)
+        if got := normalizeString(tt.value, compose, compat); got != tt.want {
+            t.Errorf("%s(%+q) = %+q, want %+q", tt.form, tt.value, got, tt.want)
+        }
+    }
+}
+
+func TestNormalizeBeforeLength(t *testing.T) {
+    decomposed := "cafe\u0301" // 6 bytes, 5 once composed
+
+    tests := []struct {
+        rules   func(f *Field)
+        message string
+    }{
+        {func(f *Field) { f.MaxLength(5) }, "cafe\u0301 cannot be more than 5 characters"},
+        {func(f *Field) { f.Normalize("NFC").MaxLength(5) }, ""},
+        {func(f *Field) { f.MinLength(6) }, ""},
+        {func(f *Field) { f.Normalize("NFC").MinLength(6) }, "caf\u00e9 cannot be less than 6 characters "},
+        {func(f *Field) { f.Normalize("NFD").MinLength(6) }, ""},
+        {func(f *Field) { f.MaxLength(5).Normalize("NFC") }, "cafe\u0301 cannot be more than 5 characters"},
+    }
+
+    for i, tt := range tests {
+        v := New()
+        tt.rules(v.Field(decomposed, "Name"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("case %d: got %q, want %q", i, got, tt.message)
+        }
+    }
+}
+
+func TestNormalizeValue(t *testing.T) {
+    v := New()
+    f := v.Field("cafe\u0301", "Name").Normalize("")
+    if errs := v.Validate(false); errs != nil {
+        t.Fatal(errs)
+    }
+    if got := f.Value(); got != "caf\u00e9" {
+        t.Errorf("Value() = %+q, want the NFC form", got)
+    }
+
+    v = New()
+    v.Field("cafe", "Name").Normalize("NFX")
+    if v.Err() == nil {
+        t.Error("Normalize(NFX) is not a configuration error")
+    }
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+    "bytes"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "reflect"
+    "strings"
+)
+
+// unwrapValue returns the value rules see for a registered value. The
+// database/sql null types, including sql.Null[T], become their inner
+// value when valid and nil otherwise, so Required fails and Optional
+// skips a NULL column. A json.RawMessage holding null, or nothing, becomes
+// nil. Other values are returned unchanged.
+func unwrapValue(value interface{}) interface{} {
+    switch v := value.(type) {
+    case sql.NullString:
+        return nullable(v.String, v.Valid)
+    case sql.NullInt64:
+        return nullable(v.Int64, v.Valid)
+    case sql.NullInt32:
+        return nullable(v.Int32, v.Valid)
+    case sql.NullInt16:
+        return nullable(v.Int16, v.Valid)
+    case sql.NullByte:
+        return nullable(v.Byte, v.Valid)
+    case sql.NullFloat64:
+        return nullable(v.Float64, v.Valid)
+    case sql.NullBool:
+        return nullable(v.Bool, v.Valid)
+    case sql.NullTime:
+        return nullable(v.Time, v.Valid)
+    case json.RawMessage:
+        if trimmed := bytes.TrimSpace(v); len(trimmed) == 0 || string(trimmed) == "null" {
+            return nil
+        }
+        return v
+    }
+
+    // sql.Null[T] is generic, so it is recognized by its type instead.
+    rv := reflect.ValueOf(value)
+    if rv.Kind() == reflect.Struct && rv.Type().PkgPath() == "database/sql" &&
+        strings.HasPrefix(rv.Type().Name(), "Null[") {
+        if !rv.FieldByName("Valid").Bool() {
+            return nil
+        }
+        return rv.FieldByName("V").Interface()
+    }
+    return value
+}
+
+// nullable returns value when valid is set, and nil otherwise.
+func nullable(value interface{}, valid bool) interface{} {
+    if !valid {
+        return nil
+    }
+    return value
+}
+
+// AsString converts a []byte or json.RawMessage value to a string so that
+// string rules such as MinLength and Email can follow it. The string rules
+// don't accept []byte themselves, since MinItems, Each and the other list
+// rules treat it as a list of bytes. A
+// json.RawMessage holding a JSON string is decoded, so `"a@b.co"` becomes
+// a@b.co; other JSON, such as a number, is kept as its text. Strings are
+// left unchanged and other values fail.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.AsString().Email()
+//    f.AsString("Note must be text")
+func (f *Field) AsString(messages ...string) *Field {
+    f.addRule("asString", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        switch v := f.value.(type) {
+        case string:
+            return nil
+        case json.RawMessage:
+            var str string
+            if err := json.Unmarshal(v, &str); err == nil {
+                f.value = str
+            } else {
+                f.value = string(bytes.TrimSpace(v))
+            }
+            return nil
+        case []byte:
+            f.value = string(v)
+            return nil
+        }
+
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return mismatch(f, "a string")
+    })
+    return f
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+    "database/sql"
+    "encoding/json"
+    "testing"
+    "time"
+)
+
+func TestNullStringRequiredAndOptional(t *testing.T) {
+    tests := []struct {
+        value   sql.NullString
+        rules   func(f *Field)
+        message string
+    }{
+        {sql.NullString{}, func(f *Field) { f.Required() }, "Nickname is required"},
+        {sql.NullString{String: "ada", Valid: false}, func(f *Field) { f.Required() }, "Nickname is required"},
+        {sql.NullString{String: "ada", Valid: true}, func(f *Field) { f.Required() }, ""},
+        {sql.NullString{}, func(f *Field) { f.Optional().MinRunes(3) }, ""},
+        {sql.NullString{String: "a", Valid: false}, func(f *Field) { f.Optional().MinRunes(3) }, ""},
+        {sql.NullString{String: "a", Valid: true}, func(f *Field) { f.Optional().MinRunes(3) }, "Nickname must be at least 3 characters long"},
+    }
+
+    for i, tt := range tests {
+        v := New()
+        tt.rules(v.Field(tt.value, "Nickname"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("case %d (%+v): got %q, want %q", i, tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestNullInt64MinMax(t *testing.T) {
+    tests := []struct {
+        value   sql.NullInt64
+        message string
+    }{
+        {sql.NullInt64{Int64: 30, Valid: true}, ""},
+        {sql.NullInt64{Int64: 15, Valid: true}, "15 cannot be less than 18"},
+        {sql.NullInt64{Int64: 130, Valid: true}, "130 cannot be greater than 120"},
+        {sql.NullInt64{Int64: 15, Valid: false}, ""},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Age").Optional().Min(18).Max(120)
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%+v: got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+
+    v := New()
+    v.Field(sql.NullInt64{}, "Age").Required().Min(18)
+    if got, want := firstError(v), "Age is required"; got != want {
+        t.Errorf("NULL age: got %q, want %q", got, want)
+    }
+}
+
+func TestUnwrapValue(t *testing.T) {
+    now := time.Now()
+    tests := []struct {
+        value interface{}
+        want  interface{}
+    }{
+        {sql.NullString{String: "a", Valid: true}, "a"},
+        {sql.NullInt32{Int32: 7, Valid: true}, int32(7)},
+        {sql.NullInt16{}, nil},
+        {sql.NullByte{Byte: 1, Valid: true}, byte(1)},
+        {sql.NullFloat64{Float64: 1.5, Valid: true}, 1.5},
+        {sql.NullBool{Bool: false, Valid: true}, false},
+        {sql.NullBool{}, nil},
+        {sql.NullTime{Time: now, Valid: true}, now},
+        {sql.Null[string]{V: "b", Valid: true}, "b"},
+        {sql.Null[int]{V: 3}, nil},
+        {json.RawMessage("null"), nil},
+        {json.RawMessage("  "), nil},
+        {"plain", "plain"},
+    }
+
+    for _, tt := range tests {
+        if got := unwrapValue(tt.value); got != tt.want {
+            t.Errorf("unwrapValue(%#v) = %#v, want %#v", tt.value, got, tt.want)
+        }
+    }
+}
+
+func TestAsStringEmail(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        message string
+    }{
+        {[]byte("test@example.com"), ""},
+        {json.RawMessage(`"test@example.com"`), ""},
+        {"test@example.com", ""},
+        {[]byte("not an email"), "Email must be a valid email"},
+        {[]byte(""), "Email is required"},
+        {42, "Email must be a string"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        f := v.Field(tt.value, "Email").AsString().Required().Email()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%#v: got %q, want %q", tt.value, got, tt.message)
+            continue
+        }
+        if tt.message == "" && f.Value() != "test@example.com" {
+            t.Errorf("%#v: Value() = %#v, want the string", tt.value, f.Value())
+        }
+    }
+
+    // Without AsString, the string rules reject bytes.
+    v := New()
+    v.Field([]byte("test@example.com"), "Email").Email()
+    if firstError(v) == "" {
+        t.Error("Email accepted a []byte without AsString")
+    }
+}
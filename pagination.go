@@ -0,0 +1,98 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "slices"
+    "strconv"
+    "strings"
+)
+
+// defaultMaxPerPage is the per-page limit Pagination uses when
+// PaginationOpts.MaxPerPage is not set.
+const defaultMaxPerPage = 100
+
+// PaginationOpts configures Pagination.
+type PaginationOpts struct {
+    // MaxPerPage is the largest allowed page size. Defaults to 100.
+    MaxPerPage int
+    // AllowedSortFields lists the fields the list can be sorted by, without
+    // a "-" prefix. When it is empty, any sort value fails.
+    AllowedSortFields []string
+}
+
+// Pagination registers the usual list endpoint parameters as three fields,
+// "Page", "Per Page" and "Sort":
+//   - page must be a whole number of at least 1,
+//   - perPage a whole number between 1 and opts.MaxPerPage,
+//   - sort one of opts.AllowedSortFields, optionally prefixed with "-" for
+//     descending order.
+//
+// page and perPage may be numbers or strings read from the query string.
+// Every parameter is optional: nil and "" pass, so handlers can apply their
+// defaults. Use Value on the returned page and per-page fields after Validate
+// to get the numbers as ints.
+//
+// Example:
+//
+//    q := r.URL.Query()
+//    page, perPage := v.Pagination(q.Get("page"), q.Get("per_page"), q.Get("sort"),
+//        validator.PaginationOpts{MaxPerPage: 50, AllowedSortFields: []string{"name", "created_at"}})
+func (v *Validator) Pagination(page, perPage interface{}, sort string, opts PaginationOpts) (pageField, perPageField *Field) {
+    maxPerPage := opts.MaxPerPage
+    if maxPerPage <= 0 {
+        maxPerPage = defaultMaxPerPage
+    }
+
+    pageField = v.Field(page, "Page").Optional()
+    pageField.addRule("pageNumber", []interface{}{1}, func(f *Field) error {
+        n, ok := wholeNumber(f.value)
+        if !ok || n < 1 {
+            return fmt.Errorf("%s must be a whole number of at least 1", f.name)
+        }
+        f.value = n
+        return nil
+    })
+
+    perPageField = v.Field(perPage, "Per Page").Optional()
+    perPageField.addRule("perPage", []interface{}{1, maxPerPage}, func(f *Field) error {
+        n, ok := wholeNumber(f.value)
+        if !ok || n < 1 || n > maxPerPage {
+            return fmt.Errorf("%s must be a whole number between 1 and %d", f.name, maxPerPage)
+        }
+        f.value = n
+        return nil
+    })
+
+    allowed := slices.Clone(opts.AllowedSortFields)
+    v.Field(sort, "Sort").Optional().addRule("sortField", []interface{}{allowed}, func(f *Field) error {
+        str, _ := f.value.(string)
+        if slices.Contains(allowed, strings.TrimPrefix(str, "-")) {
+            return nil
+        }
+        if len(allowed) == 0 {
+            return fmt.Errorf("%s is not supported", f.name)
+        }
+        return fmt.Errorf(`%s must be one of %s, optionally prefixed with "-" for descending order`,
+            f.name, strings.Join(allowed, ", "))
+    })
+    return pageField, perPageField
+}
+
+// wholeNumber converts an integer, a float holding a whole number or a
+// string of digits to an int. Huge numbers are capped so they fail range
+// checks instead of overflowing.
+func wholeNumber(value interface{}) (int, bool) {
+    if str, ok := value.(string); ok {
+        n, err := strconv.Atoi(str)
+        return n, err == nil
+    }
+    if !isInteger(value) {
+        return 0, false
+    }
+    n, _ := toFloat64(value)
+    if n > math.MaxInt32 {
+        return math.MaxInt32, true
+    }
+    return int(n), true
+}
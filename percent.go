@@ -0,0 +1,140 @@
+package validator
+
+import (
+    "fmt"
+    "math"
+    "strconv"
+    "strings"
+)
+
+// PercentOpts configures PercentWith and PercentStringWith. The zero value
+// accepts 0 to 100.
+type PercentOpts struct {
+    // Min and Max bound the percentage. A Max of 0 means 100.
+    Min float64
+    Max float64
+    // AllowNegative accepts negative percentages, down to Min, or down to
+    // -Max when Min is 0. Without it negative values are always rejected.
+    AllowNegative bool
+}
+
+// bounds returns the range of percentages accepted by opts.
+func (opts PercentOpts) bounds() (min, max float64) {
+    min, max = opts.Min, opts.Max
+    if max == 0 {
+        max = 100
+    }
+    switch {
+    case !opts.AllowNegative && min < 0:
+        min = 0
+    case opts.AllowNegative && min == 0:
+        min = -max
+    }
+    return min, max
+}
+
+// Percent validates that the value is a number from 0 to 100, such as 15
+// or 12.5, and converts it to the fraction it stands for, a float64 such as
+// 0.15, for the rules after it and for Value.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Percent() // 15 becomes 0.15
+func (f *Field) Percent(messages ...string) *Field {
+    return f.PercentWith(PercentOpts{}, messages...)
+}
+
+// PercentWith is Percent with other bounds, or negative percentages.
+// Bounds with Min above Max are a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PercentWith(validator.PercentOpts{Max: 50})
+//    f.PercentWith(validator.PercentOpts{AllowNegative: true}) // -100 to 100
+func (f *Field) PercentWith(opts PercentOpts, messages ...string) *Field {
+    min, max := opts.bounds()
+    f.addRule("percent", []interface{}{min, max}, func(f *Field) error {
+        value, ok := toFloat64(f.value)
+        return checkPercent(f, value, ok, min, max, messages)
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// PercentString validates that the value is a string holding a number
+// from 0 to 100, with an optional trailing "%", such as "15" or "12.5%",
+// and converts it to the fraction it stands for, a float64 such as 0.15.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PercentString() // "15%" becomes 0.15
+func (f *Field) PercentString(messages ...string) *Field {
+    return f.PercentStringWith(PercentOpts{}, messages...)
+}
+
+// PercentStringWith is PercentString with other bounds, or negative
+// percentages. See PercentWith.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PercentStringWith(validator.PercentOpts{AllowNegative: true}) // "-5%"
+func (f *Field) PercentStringWith(opts PercentOpts, messages ...string) *Field {
+    min, max := opts.bounds()
+    f.addRule("percentString", []interface{}{min, max}, func(f *Field) error {
+        str, ok := f.value.(string)
+        var value float64
+        if ok {
+            text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(str), "%"))
+            ok = decimalPattern.MatchString(text)
+            value, _ = strconv.ParseFloat(text, 64)
+        }
+        return checkPercent(f, value, ok, min, max, messages)
+    })
+    return f.misconfigured(boundsError(min, max))
+}
+
+// checkPercent checks a percentage read from the value of f and, when it is
+// between min and max, replaces the value with the fraction.
+func checkPercent(f *Field, value float64, ok bool, min, max float64, messages []string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    if !ok || math.IsNaN(value) || value < min || value > max {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s must be a percentage between %v and %v", f.name, min, max)
+    }
+
+    f.value = value / 100
+    return nil
+}
+
+// BasisPoints validates that the value is a whole number of basis points
+// from 0 to 10000, where 100 basis points are 1%, and converts it to the
+// fraction it stands for, a float64 such as 0.15 for 1500.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.BasisPoints() // 1500 becomes 0.15
+func (f *Field) BasisPoints(messages ...string) *Field {
+    f.addRule("basisPoints", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        value, ok := toFloat64(f.value)
+        if !ok || !isInteger(f.value) || value < 0 || value > 10000 {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a whole number of basis points between 0 and 10000", f.name)
+        }
+
+        f.value = value / 10000
+        return nil
+    })
+    return f
+}
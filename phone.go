@@ -0,0 +1,79 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strings"
+)
+
+// phoneSeparators removes the separators people write between groups of
+// digits in a phone number.
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// phoneRegions maps ISO 3166-1 alpha-2 region codes to the pattern used by
+// PhoneForRegion for numbers of that region, after separators are removed.
+var phoneRegions = map[string]*regexp.Regexp{
+    // Nigeria: mobile numbers 070, 080, 081, 090 and 091, written
+    // nationally with a leading 0 or internationally with 234.
+    "NG": regexp.MustCompile(`^(?:0|\+?234)(?:70|80|81|90|91)\d{8}$`),
+    // NANP: area code and exchange code don't start with 0 or 1.
+    "US": regexp.MustCompile(`^(?:\+?1)?[2-9]\d{2}[2-9]\d{6}$`),
+    "CA": regexp.MustCompile(`^(?:\+?1)?[2-9]\d{2}[2-9]\d{6}$`),
+    // United Kingdom: a leading 0 or 44, then a 10-digit national number
+    // (9 digits for some geographic and freephone numbers).
+    "GB": regexp.MustCompile(`^(?:0|\+?44)(?:[1-3]\d{8,9}|7\d{9}|[58]\d{8,9}|9\d{9})$`),
+    // India: an optional 0 or 91, then 10 digits starting with 2 to 9.
+    "IN": regexp.MustCompile(`^(?:0|\+?91)?[2-9]\d{9}$`),
+}
+
+// genericPhonePattern is the check PhoneForRegion applies to regions
+// without their own pattern, matching Phone.
+var genericPhonePattern = regexp.MustCompile(`^\+?[0-9]{10,15}$`)
+
+// PhoneForRegion validates that the value is a phone number of `region`,
+// an ISO 3166-1 alpha-2 code, written nationally or with the country
+// code. Spaces, hyphens, dots and parentheses are ignored. Supported
+// regions are:
+//   - NG: mobile numbers such as "0803 123 4567" or "+2348031234567",
+//   - US and CA: NANP numbers such as "(212) 555-0123" or "+1 212 555 0123",
+//   - GB: numbers such as "020 7946 0018" or "+44 7700 900123",
+//   - IN: numbers such as "98765 43210" or "+91 98765 43210".
+//
+// Numbers of other regions get the generic check of Phone, a "+" and 10
+// to 15 digits. A region that is not an assigned ISO 3166-1 alpha-2 code,
+// such as "ZZ", is a *ConfigError (see Validator.Err), so check a region
+// taken from user input with CountryCode first. The error names the
+// expected region.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.PhoneForRegion("NG")
+//    f.PhoneForRegion(user.Country, "Enter a local phone number")
+func (f *Field) PhoneForRegion(region string, messages ...string) *Field {
+    f.addRule("phoneForRegion", []interface{}{region}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        pattern, ok := phoneRegions[strings.ToUpper(region)]
+        if !ok {
+            pattern = genericPhonePattern
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !pattern.MatchString(phoneSeparators.Replace(str)) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid %s phone number", f.name, strings.ToUpper(region))
+        }
+        return nil
+    })
+
+    var err error
+    if !countryCodes[strings.ToUpper(region)] {
+        err = fmt.Errorf("region %q is not an ISO 3166-1 alpha-2 code", region)
+    }
+    return f.misconfigured(err)
+}
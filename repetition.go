@@ -0,0 +1,115 @@
+package validator
+
+import (
+    "fmt"
+    "unicode"
+)
+
+// characters splits str into the characters a reader sees: each rune with
+// the combining marks that follow it, after NFC normalization, so "é" is
+// one character whether it was sent precomposed or as "e" and an accent.
+func characters(str string) []string {
+    var chars []string
+    for _, r := range normalizeString(str, true, false) {
+        if n := len(chars); n > 0 && unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) {
+            chars[n-1] += string(r)
+            continue
+        }
+        chars = append(chars, string(r))
+    }
+    return chars
+}
+
+// MaxRepeatedRun validates that no character of the string value repeats
+// more than `n` times in a row, rejecting filler such as "aaaaaaaa" in
+// usernames or passwords. Characters are compared as written, so "A" and
+// "a" differ, and a character with accents counts once, as for
+// MinDistinctChars. An n below 1 is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxRepeatedRun(3) // "aaab" passes, "aaaab" fails
+//    // Username cannot contain more than 3 repeated characters in a row
+func (f *Field) MaxRepeatedRun(n int, messages ...string) *Field {
+    f.addRule("maxRepeatedRun", []interface{}{n}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a string")
+        }
+
+        run := 0
+        chars := characters(str)
+        for i, c := range chars {
+            run++
+            if i > 0 && c != chars[i-1] {
+                run = 1
+            }
+            if run > n {
+                if message != "" {
+                    return fmt.Errorf("%s", message)
+                }
+                return fmt.Errorf("%s cannot contain more than %d repeated characters in a row", f.name, n)
+            }
+        }
+        return nil
+    })
+
+    var err error
+    if n < 1 {
+        err = fmt.Errorf("the longest run must be at least 1, got %d", n)
+    }
+    return f.misconfigured(err)
+}
+
+// MinDistinctChars validates that the string value has at least `n`
+// different characters, rejecting values such as "abababab" that pass a
+// length check with little variety. Characters are compared as written,
+// and a character with accents counts once, however it was encoded. An n
+// below 0 is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinLength(8).MinDistinctChars(5)
+//    // Password must contain at least 5 different characters
+func (f *Field) MinDistinctChars(n int, messages ...string) *Field {
+    f.addRule("minDistinctChars", []interface{}{n}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a string")
+        }
+
+        distinct := map[string]bool{}
+        for _, c := range characters(str) {
+            distinct[c] = true
+        }
+        if len(distinct) < n {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must contain at least %d different characters", f.name, n)
+        }
+        return nil
+    })
+
+    var err error
+    if n < 0 {
+        err = fmt.Errorf("the number of characters cannot be negative, got %d", n)
+    }
+    return f.misconfigured(err)
+}
@@ -0,0 +1,350 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// Result holds the outcome of a validation run.
+type Result struct {
+    errors    []error
+    warnings  []error
+    validated []string
+    limit     int
+    truncated bool
+    err       error
+    seen      map[string]bool
+    audit     bool
+    outcomes  []RuleOutcome
+    fields    []*Field
+}
+
+// Errors returns the errors found, or nil when validation passed.
+// Errors are ordered by field registration order and, within a field,
+// by the order its rules were added. The order doesn't depend on the
+// error limit, so the first n errors of a full run are the errors of a
+// run limited to n.
+func (r *Result) Errors() []error {
+    return r.errors
+}
+
+// ErrorsMap returns the errors grouped by field key. See ErrorsMap.
+func (r *Result) ErrorsMap() map[string][]string {
+    return ErrorsMap(r.errors)
+}
+
+// Warnings returns the failures of rules registered after Field.Warn or
+// with CustomWarning, in field registration order, or nil. Warnings don't
+// affect Valid.
+func (r *Result) Warnings() []error {
+    return r.warnings
+}
+
+// Valid reports whether no errors were found. Warnings are not errors.
+func (r *Result) Valid() bool {
+    return len(r.errors) == 0
+}
+
+// Validated returns the keys of the fields that were checked, in
+// registration order, leaving out fields skipped because they were absent
+// (see FieldMaybe, IfPresent and Optional). For a PATCH request these are
+// the fields to persist. Fields after the point where validation stopped,
+// because of an error limit or an aborted run, are not listed.
+func (r *Result) Validated() []string {
+    return r.validated
+}
+
+// FieldValid reports whether the field registered under `key`, its key in
+// the input such as "email" or "filter.status" (see ValidationError.Field),
+// had no errors, for templates styling invalid inputs. Errors of the items
+// of a list field, such as "tags[1]", count as errors of the field.
+// Warnings don't. A key that no field was registered under is not valid,
+// so a typo in a template shows up as an error rather than passing
+// silently; use HasField to tell the cases apart.
+//
+// Example:
+//
+//    <input name="email" {{if not (.Result.FieldValid "email")}}class="invalid"{{end}}>
+func (r *Result) FieldValid(key string) bool {
+    return r.HasField(key) && len(r.FieldErrors(key)) == 0
+}
+
+// FieldErrors returns the messages of the errors of the field registered
+// under `key`, including those of its items, in order. It returns an empty
+// slice for a registered field without errors, and nil for a key that no
+// field was registered under.
+//
+// Example:
+//
+//    {{range .Result.FieldErrors "email"}}<p class="error">{{.}}</p>{{end}}
+func (r *Result) FieldErrors(key string) []string {
+    if !r.HasField(key) {
+        return nil
+    }
+
+    messages := []string{}
+    for _, err := range r.errors {
+        var verr ValidationError
+        if !errors.As(err, &verr) {
+            continue
+        }
+        if verr.Field == key || (strings.HasPrefix(verr.Field, key+"[") && !strings.Contains(verr.Field[len(key):], ".")) {
+            messages = append(messages, verr.Message)
+        }
+    }
+    return messages
+}
+
+// HasField reports whether a field was registered under `key` on the
+// validator that produced r, whether or not it was checked.
+func (r *Result) HasField(key string) bool {
+    for _, f := range r.fields {
+        if f.fieldKey() == key {
+            return true
+        }
+    }
+    return false
+}
+
+// Truncated reports whether validation stopped at the error limit
+// passed to ValidateN while rules were still left to check, so
+// more errors may exist than were collected.
+func (r *Result) Truncated() bool {
+    return r.truncated
+}
+
+// Err returns the error that aborted validation, such as a *LookupError
+// from a failing lookup callback, the context's error, or the *ConfigErrors
+// of misconfigured rules (see Validator.Err), or nil.
+// It is never a problem with the input itself.
+func (r *Result) Err() error {
+    return r.err
+}
+
+// add records an error and reports whether the limit still allows
+// collecting more.
+func (r *Result) add(err error) bool {
+    r.errors = append(r.errors, err)
+    return r.limit <= 0 || len(r.errors) < r.limit
+}
+
+// run checks every field, collecting at most `limit` errors
+// (no limit when it is 0 or less). It aborts when ctx is done or a
+// rule fails with a *LookupError, storing the cause in Result.Err. A
+// validator with misconfigured rules fails before checking any.
+func (v *Validator) run(ctx context.Context, limit int) *Result {
+    res := &Result{limit: limit, seen: map[string]bool{}, audit: v.audit, fields: v.fields}
+    if v.onDone != nil {
+        start := time.Now()
+        defer func() {
+            v.callHook(func() { v.onDone(len(res.errors), time.Since(start)) })
+        }()
+    }
+    // Fields from `next` on were not reached when validation stops early.
+    next := 0
+    if v.audit {
+        defer func() {
+            for _, f := range v.fields[next:] {
+                res.skip(f, f.rules, res.stopReason())
+            }
+        }()
+    }
+    if err := v.Err(); err != nil {
+        res.err = err
+        return res
+    }
+    registered := map[string]bool{}
+    v.ctx = ctx
+    defer func() { v.ctx = nil }()
+
+    resolveErrors := make([]error, len(v.fields))
+    for i, f := range v.fields {
+        resolveErrors[i] = f.resolve()
+    }
+
+    for i, f := range v.fields {
+        if err := ctx.Err(); err != nil {
+            res.err = err
+            return res
+        }
+        next = i + 1
+
+        if err := resolveErrors[i]; err != nil {
+            res.skip(f, f.rules, SkipUnresolved)
+            verr := newValidationError(f, "value", err)
+            if f.sensitive {
+                verr = f.redact(verr.(ValidationError))
+            }
+            if !res.add(verr) {
+                res.truncated = i < len(v.fields)-1
+                return res
+            }
+            continue
+        }
+
+        if v.uniqueNames {
+            key := f.fieldKey()
+            if registered[key] {
+                err := ValidationError{
+                    Field:   key,
+                    Rule:    "duplicate",
+                    Message: fmt.Sprintf("%s is registered more than once", f.name),
+                }
+                res.skip(f, f.rules, SkipDuplicate)
+                if !res.add(err) {
+                    res.truncated = i < len(v.fields)-1
+                    return res
+                }
+                continue
+            }
+            registered[key] = true
+        }
+
+        if v.strict && len(f.rules) == 0 {
+            err := ValidationError{
+                Field:   f.fieldKey(),
+                Rule:    "rules",
+                Message: fmt.Sprintf("%s has no validation rules", f.name),
+            }
+            if !res.add(err) {
+                res.truncated = i < len(v.fields)-1
+                return res
+            }
+            continue
+        }
+
+        if f.absent && (f.optional || f.omitAbsent) {
+            res.skip(f, f.rules, SkipAbsent)
+            continue
+        }
+        res.validated = append(res.validated, f.fieldKey())
+        if (f.optional || f.allowEmpty) && isEmpty(f.value) {
+            res.skip(f, f.rules, SkipEmpty)
+            continue
+        }
+
+        rules, eachRules := f.rules, []*rule(nil)
+        for j, r := range f.rules {
+            if r.each {
+                rules, eachRules = f.rules[:j], f.rules[j:]
+                break
+            }
+        }
+
+        more := len(eachRules) > 0 || i < len(v.fields)-1
+        if !v.checkRules(res, f, rules, more) {
+            res.skip(f, eachRules, res.stopReason())
+            return res
+        }
+        if len(eachRules) == 0 {
+            continue
+        }
+
+        items, ok := sliceValue(f.value)
+        if !ok {
+            res.skip(f, eachRules, SkipNotList)
+        }
+        if !ok && f.value != nil {
+            err := newValidationError(f, eachRules[0].name, fmt.Errorf("%s must be a list", f.name))
+            if !res.add(err) {
+                res.truncated = i < len(v.fields)-1
+                return res
+            }
+            continue
+        }
+        for k := 0; ok && k < items.Len(); k++ {
+            more := k < items.Len()-1 || i < len(v.fields)-1
+            if !v.checkRules(res, f.item(k, items.Index(k).Interface()), eachRules, more) {
+                for k++; res.audit && k < items.Len(); k++ {
+                    res.skip(f.item(k, items.Index(k).Interface()), eachRules, res.stopReason())
+                }
+                return res
+            }
+        }
+    }
+    return res
+}
+
+// checkRules runs `rules` on f, recording their errors and warnings in res.
+// It reports false when validation must stop, because a lookup failed or
+// the error limit was reached; `more` tells whether rules of later fields
+// or items were left to check.
+func (v *Validator) checkRules(res *Result, f *Field, rules []*rule, more bool) bool {
+    for j, rule := range rules {
+        start := time.Now()
+        err := v.check(f, rule)
+        dur := time.Since(start)
+
+        var lookupErr *LookupError
+        isLookup := errors.As(err, &lookupErr)
+        if err != nil && !isLookup {
+            err = v.ruleError(f, rule, err)
+        }
+        if v.onRule != nil {
+            v.callHook(func() { v.onRule(f.fieldKey(), rule.name, err, dur) })
+        }
+        res.record(f, rule, err)
+        if err == nil {
+            continue
+        }
+        if isLookup {
+            res.err = err
+            res.skip(f, rules[j+1:], SkipAborted)
+            return false
+        }
+        verr := err
+        if v.dedup {
+            key := fmt.Sprint(rule.warning, "\x00", f.fieldKey(), "\x00", verr.Error())
+            if res.seen[key] {
+                continue
+            }
+            res.seen[key] = true
+        }
+        if rule.warning {
+            res.warnings = append(res.warnings, verr)
+            continue
+        }
+        if !res.add(verr) {
+            res.truncated = j < len(rules)-1 || more
+            res.skip(f, rules[j+1:], SkipStopped)
+            return false
+        }
+    }
+    return true
+}
+
+// callHook calls an observer hook, ignoring a panic inside it unless
+// recovery was disabled with RecoverPanics(false).
+func (v *Validator) callHook(hook func()) {
+    if !v.noRecover {
+        defer func() { recover() }()
+    }
+    hook()
+}
+
+// check runs a single rule on f. Unless recovery was disabled with
+// RecoverPanics(false), a panic inside the rule is converted into an
+// error for the field, so the remaining rules and fields still run.
+func (v *Validator) check(f *Field, r *rule) (err error) {
+    if !v.noRecover {
+        defer func() {
+            if recovered := recover(); recovered != nil {
+                panicErr := &PanicError{Value: recovered}
+                if v.debug {
+                    panicErr.Stack = debug.Stack()
+                }
+                err = ValidationError{
+                    Field:   f.fieldKey(),
+                    Rule:    r.name,
+                    Message: fmt.Sprintf("%s could not be validated: rule %s panicked: %v", f.name, r.name, recovered),
+                    Err:     panicErr,
+                }
+            }
+        }()
+    }
+    return r.check(f)
+}
@@ -0,0 +1,65 @@
+package validator
+
+import (
+    "fmt"
+    "unicode/utf8"
+)
+
+// MinRunes validates that the string value has at least `n` Unicode code
+// points, as counted by utf8.RuneCountInString, so "café" has 4 where
+// MinLength counts 5 bytes. This is how JSON Schema's minLength and
+// go-playground's min count, so it is what ToJSONSchema exports. Emoji
+// sequences and combining accents count one per code point; use
+// MinGraphemes to count characters as users see them. A negative n is a
+// *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinRunes(2) // "Zé" passes, "Z" fails
+func (f *Field) MinRunes(n int, messages ...string) *Field {
+    f.addRule("minRunes", []interface{}{n}, func(f *Field) error {
+        return checkRunes(f, messages, func(count int) bool { return count >= n },
+            fmt.Sprintf("must be at least %d characters long", n))
+    })
+    return f.misconfigured(graphemeLimitError(n))
+}
+
+// MaxRunes validates that the string value has at most `n` Unicode code
+// points, counted like MinRunes, such as the maxLength of a JSON Schema.
+// A negative n is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxRunes(5) // "ééééé" passes, while MaxLength(5) rejects its 10 bytes
+func (f *Field) MaxRunes(n int, messages ...string) *Field {
+    f.addRule("maxRunes", []interface{}{n}, func(f *Field) error {
+        return checkRunes(f, messages, func(count int) bool { return count <= n },
+            fmt.Sprintf("cannot be longer than %d characters", n))
+    })
+    return f.misconfigured(graphemeLimitError(n))
+}
+
+// checkRunes checks the code point count of the value of f with `ok`,
+// reporting `problem` when it fails.
+func checkRunes(f *Field, messages []string, ok func(count int) bool, problem string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, isString := f.value.(string)
+    if !isString {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return mismatch(f, "a string")
+    }
+
+    if !ok(utf8.RuneCountInString(str)) {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s %s", f.name, problem)
+    }
+    return nil
+}
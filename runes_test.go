@@ -0,0 +1,35 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestRunes(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        rule    func(f *Field)
+        message string
+    }{
+        {"ééééé", func(f *Field) { f.MaxRunes(5) }, ""},
+        {"éééééé", func(f *Field) { f.MaxRunes(5) }, "Name cannot be longer than 5 characters"},
+        {"Zé", func(f *Field) { f.MinRunes(2) }, ""},
+        {"Z", func(f *Field) { f.MinRunes(2) }, "Name must be at least 2 characters long"},
+        {"👍🏽", func(f *Field) { f.MinRunes(2) }, ""},
+        {"Zé", func(f *Field) { f.MinRunes(2, "Too short") }, ""},
+        {"Z", func(f *Field) { f.MinRunes(2, "Too short") }, "Too short"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        tt.rule(v.Field(tt.value, "Name"))
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%q: got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+
+    v := New()
+    v.Field("Ada", "Name").MaxRunes(-1)
+    if v.Err() == nil {
+        t.Error("MaxRunes(-1) is not a configuration error")
+    }
+}
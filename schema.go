@@ -0,0 +1,226 @@
+package validator
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Schema is a reusable set of field definitions.
+// Fields are declared by key, and their values are looked up in the
+// input every time the schema is used, so one Schema can validate
+// any number of documents.
+//
+// Example:
+//
+//    signup := validator.NewSchema(func(s *validator.Schema) {
+//        s.Field("email", "Email").Required().Email()
+//        s.List("tags", "Tags").MinItems(1)
+//    })
+//
+//    errs := signup.ValidateValues(r.PostForm, false)
+type Schema struct {
+    fields []*Field
+}
+
+// NewSchema creates a Schema and lets `build` declare its fields.
+//
+// Example:
+//
+//    s := validator.NewSchema(func(s *validator.Schema) {
+//        s.Field("page", "Page").IntegerString().Min(1)
+//    })
+func NewSchema(build func(s *Schema)) *Schema {
+    s := &Schema{}
+    if build != nil {
+        build(s)
+    }
+    return s
+}
+
+// Field declares a field read from the input by `key`.
+// The optional `name` is used in error messages and defaults to the key.
+// When the input holds several values for the key, the first one is used.
+//
+// Example:
+//
+//    s.Field("email", "Email").Required().Email()
+func (s *Schema) Field(key string, name ...string) *Field {
+    label := key
+    if len(name) > 0 {
+        label = name[0]
+    }
+
+    f := &Field{name: label, key: key}
+    s.fields = append(s.fields, f)
+    return f
+}
+
+// List declares a field whose value is every value sent for `key`,
+// as a []string. Use it for repeated keys such as checkboxes.
+//
+// Example:
+//
+//    s.List("tags", "Tags").MinItems(1).MaxItems(5)
+func (s *Schema) List(key string, name ...string) *Field {
+    f := s.Field(key, name...)
+    f.list = true
+    return f
+}
+
+// Extend returns a new schema with copies of every field of s, followed
+// by the fields declared by `build`. s is not changed, even when rules
+// are added to the copied fields.
+//
+// Example:
+//
+//    createUser := baseUser.Extend(func(s *validator.Schema) {
+//        s.Field("password", "Password").Required().MinLength(8)
+//    })
+func (s *Schema) Extend(build func(s *Schema)) *Schema {
+    extended := &Schema{fields: make([]*Field, 0, len(s.fields))}
+    for _, f := range s.fields {
+        extended.fields = append(extended.fields, f.copyDef())
+    }
+    if build != nil {
+        build(extended)
+    }
+    return extended
+}
+
+// Pick returns a new schema with copies of only the fields named by
+// `names`, matched against field keys or names, in the order of s.
+// Unknown names are reported as an error.
+//
+// Example:
+//
+//    login, err := baseUser.Pick("email", "password")
+func (s *Schema) Pick(names ...string) (*Schema, error) {
+    return s.filter(names, true)
+}
+
+// Omit returns a new schema with copies of every field except those named
+// by `names`, matched against field keys or names. Unknown names are
+// reported as an error.
+//
+// Example:
+//
+//    updateUser, err := baseUser.Omit("password")
+func (s *Schema) Omit(names ...string) (*Schema, error) {
+    return s.filter(names, false)
+}
+
+// filter copies the fields of s that are named by `names` when `keep` is
+// set, or the others when it isn't.
+func (s *Schema) filter(names []string, keep bool) (*Schema, error) {
+    matched := make(map[string]bool, len(names))
+    filtered := &Schema{}
+    for _, f := range s.fields {
+        named := false
+        for _, name := range names {
+            if f.key == name || f.name == name {
+                matched[name] = true
+                named = true
+            }
+        }
+        if named == keep {
+            filtered.fields = append(filtered.fields, f.copyDef())
+        }
+    }
+
+    for _, name := range names {
+        if !matched[name] {
+            return nil, fmt.Errorf("validator: schema has no field %q", name)
+        }
+    }
+    return filtered, nil
+}
+
+// copyDef returns a copy of the field definition f whose rules are not
+// shared with f for appending.
+func (f *Field) copyDef() *Field {
+    c := *f
+    c.rules = f.rules[:len(f.rules):len(f.rules)]
+    return &c
+}
+
+// ValidateValues validates form or query values against the schema.
+// Every value in url.Values is a string, so:
+//   - a missing key is nil and fails Required and Present
+//   - a key sent with an empty value fails Required but passes Present
+//   - a repeated key uses its first value, unless declared with List
+// If stopOnFirst is true, it stops at the first error.
+func (s *Schema) ValidateValues(vals url.Values, stopOnFirst bool) []error {
+    v := New()
+    for _, def := range s.fields {
+        values, ok := vals[def.key]
+
+        var value interface{}
+        switch {
+        case def.list:
+            value = values
+        case len(values) > 0:
+            value = values[0]
+        }
+
+        f := def.bind(v, value)
+        f.absent = !ok
+    }
+    return v.Validate(stopOnFirst)
+}
+
+// ValidateMap validates a decoded JSON document against the schema.
+// Field keys are dotted paths, resolved as described for the package level
+// ValidateMap, so "items.*.sku" checks the "sku" of every item. Fields
+// expanded from a "*" path are named by their concrete path unless they
+// were declared with an explicit name.
+// If stopOnFirst is true, it stops at the first error.
+func (s *Schema) ValidateMap(data map[string]interface{}, stopOnFirst bool) []error {
+    v := New()
+    s.bindMap(v, data, (*Field).bind)
+    return v.Validate(stopOnFirst)
+}
+
+// bindMap registers the fields of the schema on v with their values in
+// data, using `bind` to register each one.
+func (s *Schema) bindMap(v *Validator, data map[string]interface{}, bind func(def *Field, v *Validator, value interface{}) *Field) {
+    for _, def := range s.fields {
+        for _, found := range lookupPath(data, def.key) {
+            f := bind(def, v, found.value)
+            f.key = found.path
+            f.absent = found.absent
+            if def.name == def.key {
+                f.name = found.path
+            }
+
+            if found.err != nil {
+                err := found.err
+                f.rules = nil
+                f.addRule("path", nil, func(f *Field) error {
+                    return err
+                })
+            }
+        }
+    }
+}
+
+// bind registers a copy of a schema field on v, holding `value`.
+// The copy shares the declared rules and options of the original.
+func (f *Field) bind(v *Validator, value interface{}) *Field {
+    bound := f.copyTo(v)
+    bound.raw = value
+    bound.value = value
+    return bound
+}
+
+// ValidateValues validates url.Values against the fields declared by `build`
+// and returns every error found.
+//
+// Example:
+//
+//    errs := validator.ValidateValues(r.URL.Query(), func(s *validator.Schema) {
+//        s.Field("page", "Page").IntegerString().Min(1)
+//        s.List("status", "Status").MaxItems(3)
+//    })
+func ValidateValues(vals url.Values, build func(s *Schema)) []error {
+    return NewSchema(build).ValidateValues(vals, false)
+}
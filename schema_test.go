@@ -0,0 +1,98 @@
+package validator
+
+import (
+    "net/url"
+    "reflect"
+    "testing"
+)
+
+// schemaErrors returns the messages of the errors of s for `form`.
+func schemaErrors(s *Schema, form url.Values) []string {
+    var messages []string
+    for _, err := range s.ValidateValues(form, false) {
+        messages = append(messages, err.Error())
+    }
+    return messages
+}
+
+func TestSchemaDerivation(t *testing.T) {
+    base := NewSchema(func(s *Schema) {
+        s.Field("email", "Email").Required().Email()
+        s.Field("name", "Name").Required()
+        s.Field("password", "Password").Required().MinRunes(8)
+    })
+    form := url.Values{"email": {"ada"}, "password": {"short"}}
+
+    create := base.Extend(func(s *Schema) {
+        s.Field("terms", "Terms").Required()
+    })
+    login, err := base.Pick("email", "Password")
+    if err != nil {
+        t.Fatal(err)
+    }
+    update, err := base.Omit("password")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    tests := []struct {
+        name   string
+        schema *Schema
+        want   []string
+    }{
+        {"base", base, []string{"Email must be a valid email", "Name is required", "Password must be at least 8 characters long"}},
+        {"extended", create, []string{"Email must be a valid email", "Name is required", "Password must be at least 8 characters long", "Terms is required"}},
+        {"picked", login, []string{"Email must be a valid email", "Password must be at least 8 characters long"}},
+        {"omitted", update, []string{"Email must be a valid email", "Name is required"}},
+    }
+
+    for _, tt := range tests {
+        if got := schemaErrors(tt.schema, form); !reflect.DeepEqual(got, tt.want) {
+            t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+        }
+    }
+}
+
+func TestSchemaDerivationKeepsBase(t *testing.T) {
+    base := NewSchema(func(s *Schema) {
+        s.Field("name", "Name").Required()
+    })
+    form := url.Values{"name": {"Ada"}}
+
+    // Rules added to the copied fields of a derived schema don't reach
+    // the base.
+    extended := base.Extend(func(s *Schema) {
+        s.fields[0].MinRunes(10)
+        s.Field("email", "Email").Required()
+    })
+    picked, _ := base.Pick("name")
+    picked.fields[0].MaxRunes(2)
+    omitted, _ := base.Omit()
+    omitted.fields[0].Email()
+
+    if got := schemaErrors(base, form); got != nil {
+        t.Errorf("base reports %q", got)
+    }
+    if len(base.fields) != 1 || len(base.fields[0].rules) != 1 {
+        t.Errorf("base has %d fields, the first with %d rules", len(base.fields), len(base.fields[0].rules))
+    }
+    if got := schemaErrors(extended, form); len(got) != 2 {
+        t.Errorf("extended schema errors %q, want 2", got)
+    }
+}
+
+func TestSchemaUnknownNames(t *testing.T) {
+    base := NewSchema(func(s *Schema) {
+        s.Field("email", "Email").Required()
+    })
+
+    if s, err := base.Pick("email", "phone"); err == nil || s != nil {
+        t.Errorf("Pick(phone) = %v, %v, want an error", s, err)
+    }
+    if s, err := base.Omit("phone"); err == nil || s != nil {
+        t.Errorf("Omit(phone) = %v, %v, want an error", s, err)
+    }
+    if _, err := base.Pick("phone"); err.Error() != `validator: schema has no field "phone"` {
+        t.Errorf("got %q", err)
+    }
+}
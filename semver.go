@@ -0,0 +1,395 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// semverPattern matches a semantic version, with an optional "v" prefix:
+// major, minor and patch numbers without leading zeros, then an optional
+// pre-release and build metadata.
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)` +
+    `(?:-((?:0|[1-9][0-9]*|[0-9]*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9][0-9]*|[0-9]*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+    `(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?$`)
+
+// semver is a parsed semantic version. Build metadata is dropped, since it
+// doesn't take part in comparisons.
+type semver struct {
+    major, minor, patch uint64
+    pre                 []string
+}
+
+// parseSemver parses a full semantic version such as "1.4.2-rc.1".
+func parseSemver(str string) (semver, bool) {
+    match := semverPattern.FindStringSubmatch(str)
+    if match == nil {
+        return semver{}, false
+    }
+
+    var v semver
+    var errs [3]error
+    v.major, errs[0] = strconv.ParseUint(match[1], 10, 64)
+    v.minor, errs[1] = strconv.ParseUint(match[2], 10, 64)
+    v.patch, errs[2] = strconv.ParseUint(match[3], 10, 64)
+    for _, err := range errs {
+        if err != nil {
+            return semver{}, false
+        }
+    }
+    if match[4] != "" {
+        v.pre = strings.Split(match[4], ".")
+    }
+    return v, true
+}
+
+// compare returns -1, 0 or 1 as v is lower than, equal to or higher than
+// w. A pre-release is lower than the release, and pre-release identifiers
+// are compared one by one: numbers numerically and below words, words in
+// ASCII order, and a shorter list is lower when the other starts with it.
+func (v semver) compare(w semver) int {
+    for _, d := range [][2]uint64{{v.major, w.major}, {v.minor, w.minor}, {v.patch, w.patch}} {
+        if d[0] != d[1] {
+            if d[0] < d[1] {
+                return -1
+            }
+            return 1
+        }
+    }
+
+    switch {
+    case len(v.pre) == 0 && len(w.pre) == 0:
+        return 0
+    case len(v.pre) == 0:
+        return 1
+    case len(w.pre) == 0:
+        return -1
+    }
+    for i := 0; i < len(v.pre) && i < len(w.pre); i++ {
+        if c := comparePrerelease(v.pre[i], w.pre[i]); c != 0 {
+            return c
+        }
+    }
+    switch {
+    case len(v.pre) < len(w.pre):
+        return -1
+    case len(v.pre) > len(w.pre):
+        return 1
+    }
+    return 0
+}
+
+// comparePrerelease compares two pre-release identifiers.
+func comparePrerelease(a, b string) int {
+    x, errA := strconv.ParseUint(a, 10, 64)
+    y, errB := strconv.ParseUint(b, 10, 64)
+    switch {
+    case errA == nil && errB == nil:
+        if x == y {
+            return 0
+        } else if x < y {
+            return -1
+        }
+        return 1
+    case errA == nil:
+        return -1
+    case errB == nil:
+        return 1
+    }
+    return strings.Compare(a, b)
+}
+
+// sameRelease reports whether v and w have the same major, minor and patch
+// numbers.
+func (v semver) sameRelease(w semver) bool {
+    return v.major == w.major && v.minor == w.minor && v.patch == w.patch
+}
+
+// versionBound is one comparison of a version constraint, such as ">=1.2.0".
+type versionBound struct {
+    op      string
+    version semver
+}
+
+// matches reports whether v satisfies the comparison.
+func (b versionBound) matches(v semver) bool {
+    c := v.compare(b.version)
+    switch b.op {
+    case ">":
+        return c > 0
+    case ">=":
+        return c >= 0
+    case "<":
+        return c < 0
+    case "<=":
+        return c <= 0
+    case "!=":
+        return c != 0
+    }
+    return c == 0
+}
+
+// versionConstraint is a parsed constraint: alternatives separated by "||",
+// each a list of comparisons that must all hold.
+type versionConstraint [][]versionBound
+
+// matches reports whether v satisfies one of the alternatives. A
+// pre-release only satisfies an alternative that mentions a pre-release of
+// the same major, minor and patch, so ">=1.2.0" doesn't admit "1.3.0-beta"
+// but ">=1.3.0-alpha" does.
+func (c versionConstraint) matches(v semver) bool {
+    for _, bounds := range c {
+        ok, admitsPre := true, len(v.pre) == 0
+        for _, b := range bounds {
+            ok = ok && b.matches(v)
+            if len(b.version.pre) > 0 && b.version.sameRelease(v) {
+                admitsPre = true
+            }
+        }
+        if ok && admitsPre {
+            return true
+        }
+    }
+    return false
+}
+
+// versionOperators are the operators of a comparison, longest first.
+var versionOperators = []string{">=", "<=", "!=", "==", ">", "<", "=", "^", "~"}
+
+// parseVersionConstraint parses a constraint such as ">=1.2.0 <2.0.0",
+// "^1.4" or "~1.2.3 || >=2.1.0, <3".
+func parseVersionConstraint(constraint string) (versionConstraint, error) {
+    var c versionConstraint
+    for _, alternative := range strings.Split(constraint, "||") {
+        var terms []string
+        for _, field := range strings.Fields(strings.ReplaceAll(alternative, ",", " ")) {
+            // An operator written apart from its version, as in ">= 1.2".
+            if n := len(terms); n > 0 && isVersionOperator(terms[n-1]) {
+                terms[n-1] += field
+                continue
+            }
+            terms = append(terms, field)
+        }
+        if len(terms) == 0 {
+            return nil, fmt.Errorf("empty version constraint in %q", constraint)
+        }
+
+        var bounds []versionBound
+        for _, term := range terms {
+            termBounds, err := parseVersionTerm(term)
+            if err != nil {
+                return nil, err
+            }
+            bounds = append(bounds, termBounds...)
+        }
+        c = append(c, bounds)
+    }
+    return c, nil
+}
+
+// isVersionOperator reports whether str is an operator on its own.
+func isVersionOperator(str string) bool {
+    for _, op := range versionOperators {
+        if str == op {
+            return true
+        }
+    }
+    return false
+}
+
+// parseVersionTerm turns one term of a constraint, such as "^1.2" or
+// "<=2", into the comparisons it stands for. Missing or wildcard ("x",
+// "*") parts of the version make it a range: "1.2" is >=1.2.0 <1.3.0.
+func parseVersionTerm(term string) ([]versionBound, error) {
+    op := ""
+    for _, candidate := range versionOperators {
+        if strings.HasPrefix(term, candidate) {
+            op = candidate
+            break
+        }
+    }
+    text := term[len(op):]
+    if op == "==" {
+        op = "="
+    }
+
+    v, parts, err := parsePartialVersion(text)
+    if err != nil {
+        return nil, fmt.Errorf("invalid version %q in constraint: %v", text, err)
+    }
+    if parts == 0 {
+        switch op {
+        case "", "=", ">=", "^", "~":
+            return nil, nil
+        }
+        return nil, fmt.Errorf("operator %q cannot be used with a wildcard version", op)
+    }
+
+    // next is the lowest version above the range of the partial version.
+    next := v
+    switch parts {
+    case 1:
+        next = semver{major: v.major + 1}
+    case 2:
+        next = semver{major: v.major, minor: v.minor + 1}
+    }
+    full := parts == 3
+
+    switch op {
+    case "", "=":
+        if full {
+            return []versionBound{{"=", v}}, nil
+        }
+        return []versionBound{{">=", v}, {"<", next}}, nil
+    case "!=":
+        if !full {
+            return nil, fmt.Errorf("operator != needs a full version, got %q", text)
+        }
+        return []versionBound{{"!=", v}}, nil
+    case ">":
+        if full {
+            return []versionBound{{">", v}}, nil
+        }
+        return []versionBound{{">=", next}}, nil
+    case ">=", "<":
+        return []versionBound{{op, v}}, nil
+    case "<=":
+        if full {
+            return []versionBound{{"<=", v}}, nil
+        }
+        return []versionBound{{"<", next}}, nil
+    case "~":
+        if parts == 1 {
+            return []versionBound{{">=", v}, {"<", semver{major: v.major + 1}}}, nil
+        }
+        return []versionBound{{">=", v}, {"<", semver{major: v.major, minor: v.minor + 1}}}, nil
+    }
+
+    // "^" allows changes that don't modify the leftmost non-zero part, or
+    // the last part given when they are all zero.
+    upper := semver{major: v.major + 1}
+    switch {
+    case v.major > 0 || parts == 1:
+    case v.minor > 0 || parts == 2:
+        upper = semver{minor: v.minor + 1}
+    default:
+        upper = semver{patch: v.patch + 1}
+    }
+    return []versionBound{{">=", v}, {"<", upper}}, nil
+}
+
+// parsePartialVersion parses a version of a constraint, in which the minor
+// and patch numbers may be missing or wildcards, and returns it with the
+// missing parts set to 0, and the number of parts that were given.
+func parsePartialVersion(text string) (semver, int, error) {
+    if v, ok := parseSemver(text); ok {
+        return v, 3, nil
+    }
+
+    text = strings.TrimPrefix(text, "v")
+    if text == "" {
+        return semver{}, 0, fmt.Errorf("missing version")
+    }
+    var numbers [3]uint64
+    parts := 0
+    for i, part := range strings.Split(text, ".") {
+        if i == 3 || strings.ContainsAny(part, "-+") {
+            return semver{}, 0, fmt.Errorf("not a version")
+        }
+        if part == "x" || part == "X" || part == "*" {
+            continue
+        }
+        if i > parts {
+            return semver{}, 0, fmt.Errorf("a number follows a wildcard")
+        }
+        n, err := strconv.ParseUint(part, 10, 64)
+        if err != nil || (len(part) > 1 && part[0] == '0') {
+            return semver{}, 0, fmt.Errorf("%q is not a version number", part)
+        }
+        numbers[i] = n
+        parts++
+    }
+    return semver{major: numbers[0], minor: numbers[1], patch: numbers[2]}, parts, nil
+}
+
+// Semver validates that the value is a semantic version as defined by
+// semver.org, such as "1.4.2", "2.0.0-rc.1" or "1.0.0+build.5", with an
+// optional "v" prefix.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Semver()
+//    f.Semver("Version must look like 1.4.2")
+func (f *Field) Semver(messages ...string) *Field {
+    f.addRule("semver", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok {
+            _, ok = parseSemver(str)
+        }
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a semantic version, such as 1.4.2", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// SemverConstraint validates that the value is a semantic version, as for
+// Semver, that satisfies `constraint`, such as ">=1.2.0 <2.0.0" for the
+// versions of a plugin API. A constraint is made of comparisons with =,
+// !=, >, >=, < and <=, separated by spaces or commas, that must all hold,
+// and alternatives of those separated by "||". A version may leave out the
+// patch or minor number, or write them as "x" or "*":
+//   - "1.2" is >=1.2.0 <1.3.0, and "<=1.2" is <1.3.0,
+//   - "^1.2.3" is >=1.2.3 <2.0.0, "^0.2.3" is >=0.2.3 <0.3.0 and "^0.0.3"
+//     is >=0.0.3 <0.0.4: the leftmost non-zero number can't change,
+//   - "~1.2.3" is >=1.2.3 <1.3.0 and "~1" is >=1.0.0 <2.0.0.
+//
+// Pre-releases such as "1.3.0-beta" only satisfy a constraint that names a
+// pre-release of the same version, such as ">=1.3.0-alpha", so a release
+// range never picks up unstable versions. The constraint is parsed when the
+// rule is registered; an invalid one is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.SemverConstraint(">=1.2.0 <2.0.0")
+//    f.SemverConstraint("^1.4 || ^2", "This plugin needs API version 1.4 or later")
+//    // API Version must satisfy >=1.2.0 <2.0.0
+func (f *Field) SemverConstraint(constraint string, messages ...string) *Field {
+    c, err := parseVersionConstraint(constraint)
+
+    f.addRule("semverConstraint", []interface{}{constraint}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var v semver
+        if ok {
+            v, ok = parseSemver(str)
+        }
+
+        var err error
+        switch {
+        case !ok:
+            err = fmt.Errorf("%s must be a semantic version, such as 1.4.2", f.name)
+        case !c.matches(v):
+            err = fmt.Errorf("%s must satisfy %s", f.name, constraint)
+        }
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f.misconfigured(err)
+}
@@ -0,0 +1,102 @@
+package validator
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestSemverConstraint(t *testing.T) {
+    tests := []struct {
+        constraint string
+        version    string
+        valid      bool
+    }{
+        // Caret: the leftmost non-zero number can't change.
+        {"^1.2.3", "1.2.3", true},
+        {"^1.2.3", "1.9.0", true},
+        {"^1.2.3", "1.2.2", false},
+        {"^1.2.3", "2.0.0", false},
+        {"^0.2.3", "0.2.9", true},
+        {"^0.2.3", "0.3.0", false},
+        {"^0.2", "0.2.0", true},
+        {"^0.2", "0.3.0", false},
+        {"^0.0.3", "0.0.3", true},
+        {"^0.0.3", "0.0.4", false},
+        {"^0.0.3", "0.1.0", false},
+
+        // Tilde: the patch may change, or the minor when only the major
+        // is given.
+        {"~1.2.3", "1.2.9", true},
+        {"~1.2.3", "1.3.0", false},
+        {"~1.2", "1.2.0", true},
+        {"~1.2", "1.2.99", true},
+        {"~1.2", "1.3.0", false},
+        {"~1", "1.0.0", true},
+        {"~1", "1.99.0", true},
+        {"~1", "2.0.0", false},
+
+        // Partial versions and wildcards.
+        {"1.2", "1.2.7", true},
+        {"1.2", "1.3.0", false},
+        {"<=1.2", "1.2.9", true},
+        {"<=1.2", "1.3.0", false},
+        {"1.x", "1.8.0", true},
+        {"1.x", "2.0.0", false},
+
+        // Pre-releases only match a constraint naming a pre-release of
+        // the same version.
+        {"^1.2.3", "1.3.0-beta", false},
+        {">=1.2.0 <2.0.0", "1.5.0-rc.1", false},
+        {">=1.3.0-alpha", "1.3.0-beta", true},
+        {">=1.3.0-alpha", "1.3.0", true},
+        {">=1.3.0-alpha", "1.4.0-beta", false},
+        {">=1.3.0-beta", "1.3.0-alpha", false},
+
+        // Comma and space separated ranges, and alternatives.
+        {">=1.2.0, <2.0.0", "1.5.0", true},
+        {">=1.2.0,<2.0.0", "2.0.0", false},
+        {">=1.2.0 <2.0.0", "v1.9.9", true},
+        {">1.0.0 != 1.5.0", "1.5.0", false},
+        {"^1.4 || ^2", "2.3.0", true},
+        {"^1.4 || ^2", "1.3.0", false},
+
+        {"^1.2.3", "1.2", false},
+        {"^1.2.3", "banana", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.version, "Version").SemverConstraint(tt.constraint)
+        if err := v.Err(); err != nil {
+            t.Fatalf("SemverConstraint(%q): %v", tt.constraint, err)
+        }
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("SemverConstraint(%q) of %q: valid = %v, want %v", tt.constraint, tt.version, valid, tt.valid)
+        }
+    }
+}
+
+func TestSemverConstraintMessages(t *testing.T) {
+    v := New()
+    v.Field("2.0.0", "API Version").SemverConstraint(">=1.2.0 <2.0.0")
+    if got, want := firstError(v), "API Version must satisfy >=1.2.0 <2.0.0"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    v = New()
+    v.Field("two", "API Version").SemverConstraint(">=1.2.0")
+    if got, want := firstError(v), "API Version must be a semantic version, such as 1.4.2"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
+
+func TestSemverConstraintInvalid(t *testing.T) {
+    for _, constraint := range []string{"", ">>1.2.0", "^1.x.3", "1.2.3.4", ">=01.2.0", "~banana", "^1.2 ||"} {
+        v := New()
+        v.Field("1.2.3", "Version").SemverConstraint(constraint)
+        var cfgErr *ConfigError
+        if !errors.As(v.Err(), &cfgErr) {
+            t.Errorf("SemverConstraint(%q): got %v, want a *ConfigError", constraint, v.Err())
+        }
+    }
+}
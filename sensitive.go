@@ -0,0 +1,85 @@
+package validator
+
+import (
+    "errors"
+    "fmt"
+    "slices"
+    "strconv"
+    "strings"
+)
+
+// redacted replaces the value of a sensitive field wherever it would appear.
+const redacted = "[redacted]"
+
+// Sensitive marks the field as holding a secret, such as a password, token
+// or card number, so its value never appears in errors: wherever a message,
+// the error wrapped in ValidationError.Err, a captured value (see
+// CaptureValues) or the log/slog output would contain it, "[redacted]" is
+// used instead. Rule parameters, such as the 8 of MinLength(8), are still
+// reported, unless they are equal to the value. Describe never includes
+// values.
+//
+// Example:
+//    v.Field(password, "Password").Sensitive().Required().MinLength(12)
+func (f *Field) Sensitive() *Field {
+    f.sensitive = true
+    f.changed()
+    return f
+}
+
+// redact removes the value of the sensitive field f from verr.
+func (f *Field) redact(verr ValidationError) ValidationError {
+    if verr.Value != nil {
+        verr.Value = redacted
+    }
+
+    secrets := append(secretForms(f.raw), secretForms(f.value)...)
+    if verr.Param != nil && slices.Contains(secrets, fmt.Sprint(verr.Param)) {
+        verr.Param = redacted
+    }
+    message := redactString(verr.Message, secrets)
+    if verr.Err != nil && redactString(verr.Err.Error(), secrets) != verr.Err.Error() {
+        verr.Err = errors.New(redactString(verr.Err.Error(), secrets))
+    }
+    verr.Message = message
+    return verr
+}
+
+// secretForms returns the ways value can be written in a message: its
+// default format, quoted and with quotes escaped, and the same for every
+// item of a list. Empty forms are left out.
+func secretForms(value interface{}) []string {
+    if value == nil {
+        return nil
+    }
+
+    text := fmt.Sprint(value)
+    forms := []string{text, strconv.Quote(text), strings.Trim(strconv.Quote(text), `"`)}
+    if _, isBytes := value.([]byte); isBytes {
+        return forms
+    }
+    if items, ok := sliceValue(value); ok {
+        for i := 0; i < items.Len(); i++ {
+            forms = append(forms, secretForms(items.Index(i).Interface())...)
+        }
+    }
+
+    nonEmpty := forms[:0]
+    for _, form := range forms {
+        if form != "" && form != `""` {
+            nonEmpty = append(nonEmpty, form)
+        }
+    }
+    return nonEmpty
+}
+
+// redactString replaces every secret in str with "[redacted]", longest
+// first so a quoted form is replaced as a whole.
+func redactString(str string, secrets []string) string {
+    sorted := slices.Clone(secrets)
+    slices.SortFunc(sorted, func(a, b string) int { return len(b) - len(a) })
+    for _, secret := range sorted {
+        str = strings.ReplaceAll(str, secret, redacted)
+    }
+    return str
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+    "bytes"
+    "errors"
+    "fmt"
+    "log/slog"
+    "strings"
+    "testing"
+)
+
+func TestSensitiveMaxLength(t *testing.T) {
+    const secret = "hunter2-correct-horse"
+
+    v := New().CaptureValues(true).Debug(true)
+    v.Field(secret, "Password").Sensitive().MaxLength(8)
+    v.Field([]byte(secret), "Token").Sensitive().MaxLength(8)
+    errs := v.Validate(false)
+    if len(errs) != 2 {
+        t.Fatalf("got errors %v, want one per field", errs)
+    }
+
+    for _, err := range errs {
+        var verr ValidationError
+        if !errors.As(err, &verr) {
+            t.Fatalf("%v is not a ValidationError", err)
+        }
+
+        var logged bytes.Buffer
+        slog.New(slog.NewTextHandler(&logged, nil)).Warn("invalid", "error", verr)
+
+        texts := map[string]string{
+            "Error":    err.Error(),
+            "Message":  verr.Message,
+            "Value":    fmt.Sprint(verr.Value),
+            "Param":    fmt.Sprint(verr.Param),
+            "Received": verr.Received,
+            "Err":      fmt.Sprint(verr.Err),
+            "%+v":      fmt.Sprintf("%+v", verr),
+            "slog":     logged.String(),
+        }
+        for what, text := range texts {
+            if strings.Contains(text, secret) {
+                t.Errorf("%s of %s contains the secret: %s", what, verr.Field, text)
+            }
+        }
+        if verr.Value != redacted {
+            t.Errorf("captured value of %s = %v, want %s", verr.Field, verr.Value, redacted)
+        }
+    }
+
+    for field, rules := range v.Describe() {
+        if strings.Contains(strings.Join(rules, " "), secret) {
+            t.Errorf("Describe of %s contains the secret", field)
+        }
+    }
+}
@@ -0,0 +1,139 @@
+package validator
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+)
+
+// signatureDigestLengths maps the algorithms of webhook signature headers
+// to the length of their hex digests.
+var signatureDigestLengths = map[string]int{
+    "sha1":   40,
+    "sha256": 64,
+    "sha512": 128,
+}
+
+// SignatureHeaderOpts configures SignatureHeaderWith.
+type SignatureHeaderOpts struct {
+    // Algorithms lists the accepted algorithms, among "sha1", "sha256" and
+    // "sha512", in any case. When empty, all three are accepted. Any other
+    // algorithm is a *ConfigError.
+    Algorithms []string
+    // AllowMultiple accepts several comma-separated signatures, such as
+    // "sha1=...,sha256=...", as sent by providers rotating algorithms.
+    AllowMultiple bool
+}
+
+// SignatureHeader validates that the value has the structure of a webhook
+// signature header, "algo=hexdigest", such as "sha256=" followed by 64 hex
+// digits, with one of `algos` (sha1, sha256 or sha512, all three when none
+// is given). Algorithm names are case-insensitive, and the digest must
+// have the length of the algorithm: 40, 64 or 128 hex digits. This is a
+// check of the format only: it does not verify the signature against a
+// payload or a secret. Use SignatureHeaderWith for several signatures or a
+// custom error message.
+//
+// Example:
+//    f.SignatureHeader("sha256") // "sha256=3f2a…" with 64 hex digits
+func (f *Field) SignatureHeader(algos ...string) *Field {
+    return f.SignatureHeaderWith(SignatureHeaderOpts{Algorithms: algos})
+}
+
+// SignatureHeaderWith is SignatureHeader with options, such as several
+// comma-separated signatures.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.SignatureHeaderWith(validator.SignatureHeaderOpts{
+//        Algorithms:    []string{"sha1", "sha256"},
+//        AllowMultiple: true,
+//    }) // "sha1=…,sha256=…"
+func (f *Field) SignatureHeaderWith(opts SignatureHeaderOpts, messages ...string) *Field {
+    allowed := map[string]bool{}
+    var configErr error
+    for _, algo := range opts.Algorithms {
+        algo = strings.ToLower(algo)
+        if _, ok := signatureDigestLengths[algo]; !ok && configErr == nil {
+            configErr = fmt.Errorf("unknown algorithm %q, expected sha1, sha256 or sha512", algo)
+        }
+        allowed[algo] = true
+    }
+    if len(allowed) == 0 {
+        for algo := range signatureDigestLengths {
+            allowed[algo] = true
+        }
+    }
+
+    f.addRule("signatureHeader", []interface{}{opts}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        var err error
+        if !ok || str == "" {
+            err = fmt.Errorf("%s must be a signature such as sha256=<hex digest>", f.name)
+        } else {
+            err = checkSignatureHeader(f, str, allowed, opts.AllowMultiple)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f.misconfigured(configErr)
+}
+
+// checkSignatureHeader checks each signature of the header str of f.
+func checkSignatureHeader(f *Field, str string, allowed map[string]bool, multiple bool) error {
+    signatures := []string{str}
+    if strings.Contains(str, ",") {
+        if !multiple {
+            return fmt.Errorf("%s must hold a single signature", f.name)
+        }
+        signatures = strings.Split(str, ",")
+    }
+
+    for _, signature := range signatures {
+        algo, digest, found := strings.Cut(strings.TrimSpace(signature), "=")
+        if !found || algo == "" {
+            return fmt.Errorf("%s must be a signature such as sha256=<hex digest>", f.name)
+        }
+
+        algo = strings.ToLower(algo)
+        if !allowed[algo] {
+            return fmt.Errorf("%s uses the unsupported algorithm %q, expected %s", f.name, algo, signatureAlgorithms(allowed))
+        }
+        length := signatureDigestLengths[algo]
+        if len(digest) != length || !isHex(digest) {
+            return fmt.Errorf("%s must have a %s digest of %d hex digits", f.name, algo, length)
+        }
+    }
+    return nil
+}
+
+// signatureAlgorithms lists the allowed algorithms for error messages.
+func signatureAlgorithms(allowed map[string]bool) string {
+    var names []string
+    for algo := range allowed {
+        names = append(names, algo)
+    }
+    sort.Strings(names)
+    if len(names) == 1 {
+        return names[0]
+    }
+    return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// isHex reports whether str consists of hex digits only.
+func isHex(str string) bool {
+    for i := 0; i < len(str); i++ {
+        if !isHexDigit(str[i]) {
+            return false
+        }
+    }
+    return true
+}
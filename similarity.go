@@ -0,0 +1,120 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+    "unicode/utf8"
+)
+
+// minSimilarLength is the length, in characters, below which NotSimilarTo
+// only rejects equal strings, since a single edit already changes a large
+// share of a short string.
+const minSimilarLength = 4
+
+// Similarity returns how similar a and b are, from 0 for strings with
+// nothing in common to 1 for equal strings, ignoring case. It is one minus
+// the Levenshtein distance (the number of characters inserted, deleted or
+// replaced to turn one into the other) divided by the length of the longer
+// string. Two empty strings are equal.
+//
+// Example:
+//    validator.Similarity("john.doe", "johndoe1") // 0.75
+func Similarity(a, b string) float64 {
+    a, b = strings.ToLower(a), strings.ToLower(b)
+    longest := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+    if longest == 0 {
+        return 1
+    }
+    return 1 - float64(levenshtein([]rune(a), []rune(b)))/float64(longest)
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b []rune) int {
+    prev := make([]int, len(b)+1)
+    cur := make([]int, len(b)+1)
+    for j := range prev {
+        prev[j] = j
+    }
+
+    for i := 1; i <= len(a); i++ {
+        cur[0] = i
+        for j := 1; j <= len(b); j++ {
+            cost := 1
+            if a[i-1] == b[j-1] {
+                cost = 0
+            }
+            cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+        }
+        prev, cur = cur, prev
+    }
+    return prev[len(b)]
+}
+
+// NotSimilarTo validates that the string value is not too similar to
+// `other`, such as a password compared with the username: it fails when
+// Similarity is above `threshold`, so 0.7 rejects "johndoe1" for
+// "john.doe" (0.75). Case is ignored. When either string is shorter than 4
+// characters only equal strings are rejected, and an empty `other` is
+// not checked, so an optional username doesn't reject every password.
+// The error doesn't quote `other`; use NotSimilarToField to name the
+// field it comes from.
+// Accepts an optional custom error message.
+//
+// Example:
+//    v.Field(password, "Password").NotSimilarTo(emailLocalPart, 0.7, "Password is too close to your email")
+func (f *Field) NotSimilarTo(other string, threshold float64, messages ...string) *Field {
+    f.addRule("notSimilarTo", []interface{}{threshold}, func(f *Field) error {
+        return checkSimilar(f, other, "another value", threshold, messages)
+    })
+    return f
+}
+
+// NotSimilarToField validates that the string value is not too similar to
+// the value of `other`, like NotSimilarTo, naming `other` in the error.
+// The check is skipped while `other` is empty or not a string, leaving
+// that to its own rules.
+// Accepts an optional custom error message.
+//
+// Example:
+//    username := v.Field(req.Username, "Username").Required()
+//    v.Field(req.Password, "Password").Sensitive().MinLength(8).NotSimilarToField(username, 0.7)
+//    // "Password is too similar to Username"
+func (f *Field) NotSimilarToField(other *Field, threshold float64, messages ...string) *Field {
+    f.addRule("notSimilarToField", []interface{}{other.fieldKey(), threshold}, func(f *Field) error {
+        str, _ := other.value.(string)
+        return checkSimilar(f, str, other.name, threshold, messages)
+    })
+    return f
+}
+
+// checkSimilar checks that the value of f is not too similar to `other`,
+// described as `otherName` in the error.
+func checkSimilar(f *Field, other, otherName string, threshold float64, messages []string) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    str, ok := f.value.(string)
+    if !ok {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return mismatch(f, "a string")
+    }
+    if other == "" {
+        return nil
+    }
+
+    similar := Similarity(str, other) > threshold
+    if utf8.RuneCountInString(str) < minSimilarLength || utf8.RuneCountInString(other) < minSimilarLength {
+        similar = strings.EqualFold(str, other)
+    }
+    if similar {
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s is too similar to %s", f.name, otherName)
+    }
+    return nil
+}
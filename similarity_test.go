@@ -0,0 +1,57 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestSimilarity(t *testing.T) {
+    tests := []struct {
+        a, b string
+        want float64
+    }{
+        {"john.doe", "john.doe", 1},
+        {"John.Doe", "john.doe", 1},
+        {"", "", 1},
+        {"abcd", "wxyz", 0},
+        {"john.doe", "johndoe1", 0.75},
+    }
+
+    for _, tt := range tests {
+        if got := Similarity(tt.a, tt.b); got != tt.want {
+            t.Errorf("Similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+        }
+    }
+}
+
+func TestNotSimilarTo(t *testing.T) {
+    tests := []struct {
+        password, username string
+        message            string
+    }{
+        {"johndoe1", "john.doe", "Password is too similar to another value"},
+        {"JOHN.DOE", "john.doe", "Password is too similar to another value"},
+        {"correct horse battery", "john.doe", ""},
+        {"abc", "abd", ""},
+        {"abc", "ABC", "Password is too similar to another value"},
+        {"johndoe1", "", ""},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.password, "Password").NotSimilarTo(tt.username, 0.7)
+        if got := firstError(v); got != tt.message {
+            t.Errorf("NotSimilarTo(%q) of %q: got %q, want %q", tt.username, tt.password, got, tt.message)
+        }
+    }
+}
+
+func TestNotSimilarToField(t *testing.T) {
+    v := New()
+    username := v.Field("john.doe", "Username")
+    v.Field("johndoe1", "Password").NotSimilarToField(username, 0.7)
+
+    want := "Password is too similar to Username"
+    if got := firstError(v); got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
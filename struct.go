@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validatable is implemented by types that know how to validate themselves.
+// Rules registers the type's fields on v; field names are prefixed
+// automatically when the type is nested inside another struct.
+//
+// Example:
+//
+//    func (a Address) Rules(v *validator.Validator) {
+//        v.Field(a.City, "City").Required()
+//        v.Field(a.Zip, "Zip").Required().MinLength(5)
+//    }
+type Validatable interface {
+    Rules(v *Validator)
+}
+
+// Struct registers the rules of obj when it implements Validatable.
+// Exported fields of obj (including elements of slices and arrays) that
+// implement Validatable are visited recursively, with the Go field name
+// used as a prefix: a nested Address field reports "Address.City is required"
+// and the third element of Items reports "Items[2].Sku is required".
+// Nil pointers are skipped.
+//
+// Example:
+//
+//    v := validator.New()
+//    v.Struct(order)
+//    errs := v.Validate(false)
+func (v *Validator) Struct(obj interface{}) *Validator {
+    v.registerStruct(reflect.ValueOf(obj), map[uintptr]bool{})
+    return v
+}
+
+// registerStruct calls Rules on rv when it is Validatable and then
+// walks its fields. `seen` guards against pointer cycles.
+func (v *Validator) registerStruct(rv reflect.Value, seen map[uintptr]bool) {
+    if !rv.IsValid() {
+        return
+    }
+
+    if rv.Kind() == reflect.Ptr {
+        if rv.IsNil() || seen[rv.Pointer()] {
+            return
+        }
+        seen[rv.Pointer()] = true
+    }
+
+    if validatable, ok := asValidatable(rv); ok {
+        validatable.Rules(v)
+    }
+
+    for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+        if rv.IsNil() {
+            return
+        }
+        rv = rv.Elem()
+    }
+
+    if rv.Kind() != reflect.Struct {
+        return
+    }
+
+    t := rv.Type()
+    for i := 0; i < t.NumField(); i++ {
+        field := t.Field(i)
+        if !field.IsExported() || !mayValidate(field.Type, map[reflect.Type]bool{}) {
+            continue
+        }
+
+        child := rv.Field(i)
+        switch child.Kind() {
+        case reflect.Slice, reflect.Array:
+            for j := 0; j < child.Len(); j++ {
+                v.scoped(fmt.Sprintf("%s[%d]", field.Name, j)).registerStruct(child.Index(j), seen)
+            }
+        default:
+            v.scoped(field.Name).registerStruct(child, seen)
+        }
+    }
+}
+
+// validatableType is the reflect.Type of the Validatable interface.
+var validatableType = reflect.TypeOf((*Validatable)(nil)).Elem()
+
+// mayValidate reports whether values of type t can hold a Validatable,
+// directly or in a nested field or element, so that unrelated fields
+// are never walked. `visiting` guards against recursive types.
+func mayValidate(t reflect.Type, visiting map[reflect.Type]bool) bool {
+    if visiting[t] {
+        return false
+    }
+    visiting[t] = true
+
+    if t.Implements(validatableType) || reflect.PointerTo(t).Implements(validatableType) {
+        return true
+    }
+
+    switch t.Kind() {
+    case reflect.Ptr, reflect.Slice, reflect.Array:
+        return mayValidate(t.Elem(), visiting)
+    case reflect.Interface:
+        return true
+    case reflect.Struct:
+        for i := 0; i < t.NumField(); i++ {
+            if t.Field(i).IsExported() && mayValidate(t.Field(i).Type, visiting) {
+                return true
+            }
+        }
+    }
+    return false
+}
+
+// asValidatable returns rv as a Validatable, also trying its address
+// so that types with pointer receivers are found.
+func asValidatable(rv reflect.Value) (Validatable, bool) {
+    if rv.CanInterface() {
+        if validatable, ok := rv.Interface().(Validatable); ok {
+            return validatable, true
+        }
+    }
+    if rv.CanAddr() && rv.Addr().CanInterface() {
+        if validatable, ok := rv.Addr().Interface().(Validatable); ok {
+            return validatable, true
+        }
+    }
+    return nil, false
+}
@@ -0,0 +1,314 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// StructTags registers rules declared in `validate` struct tags, using the
+// syntax of github.com/go-playground/validator so tagged structs can be
+// migrated without rewriting them:
+//
+//    type SignupRequest struct {
+//        Email string   `validate:"required,email,max=64"`
+//        Plan  string   `validate:"oneof=free pro team"`
+//        Age   int      `validate:"omitempty,gte=18,lte=120"`
+//        Tags  []string `validate:"max=5,dive,min=2"`
+//    }
+//
+// Supported tags are required, omitempty, email, url, uuid, min, max, len,
+// eq, ne, gt, gte, lt, lte, oneof, and dive. As in go-playground, min, max,
+// len, gt, gte, lt and lte compare the length of strings (in characters),
+// the number of items of slices and maps, and the value of numbers; eq and
+// ne compare strings by value. dive applies the tags after it to every
+// element of a slice, and nested structs are visited with the Go field name
+// as a prefix ("Address.City is required").
+//
+// Any other tag is reported as an error naming the tag and field, and no
+// field of obj is registered.
+//
+// Example:
+//
+//    v := validator.New()
+//    if err := v.StructTags(&req); err != nil {
+//        panic(err) // a programming error in the struct tags
+//    }
+//    errs := v.Validate(false)
+func (v *Validator) StructTags(obj interface{}) error {
+    staged := &Validator{prefix: v.prefix}
+    if err := staged.registerTags(reflect.ValueOf(obj)); err != nil {
+        return err
+    }
+
+    owner := v.root()
+    for _, f := range staged.fields {
+        f.copyTo(owner)
+    }
+    return nil
+}
+
+// registerTags registers the tagged fields of the struct held by rv.
+func (v *Validator) registerTags(rv reflect.Value) error {
+    for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+        if rv.IsNil() {
+            return nil
+        }
+        rv = rv.Elem()
+    }
+    if rv.Kind() != reflect.Struct {
+        return fmt.Errorf("validator: StructTags expects a struct, got %s", rv.Kind())
+    }
+
+    t := rv.Type()
+    for i := 0; i < t.NumField(); i++ {
+        field := t.Field(i)
+        if !field.IsExported() {
+            continue
+        }
+
+        tag, tagged := field.Tag.Lookup("validate")
+        if tag == "-" {
+            continue
+        }
+
+        value := rv.Field(i)
+        if !tagged {
+            if isNestedStruct(value) {
+                if err := v.scoped(field.Name).registerTags(value); err != nil {
+                    return err
+                }
+            }
+            continue
+        }
+
+        if err := v.registerTag(value, field.Name, splitTag(tag)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// registerTag registers one field with the given tags. Tags after
+// "dive" are registered on every element of the field instead.
+func (v *Validator) registerTag(rv reflect.Value, name string, tags []string) error {
+    for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+        if rv.IsNil() {
+            break
+        }
+        rv = rv.Elem()
+    }
+
+    var value interface{}
+    isNil := (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil()
+    if rv.IsValid() && rv.CanInterface() && !isNil {
+        value = rv.Interface()
+    }
+
+    // An empty omitempty field is not registered at all, rather than left
+    // without rules, which StrictFields would report.
+    for _, tag := range tags {
+        if tag == "dive" {
+            break
+        }
+        if tag == "omitempty" && (value == nil || rv.IsZero()) {
+            return nil
+        }
+    }
+
+    f := v.Field(value, name)
+    for i, tag := range tags {
+        tagName, param, _ := strings.Cut(tag, "=")
+
+        switch tagName {
+        case "omitempty":
+        case "dive":
+            if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+                return fmt.Errorf("validator: validate tag \"dive\" on field %s requires a slice or array", f.name)
+            }
+            if value == nil {
+                return nil
+            }
+            for j := 0; j < rv.Len(); j++ {
+                if err := v.registerTag(rv.Index(j), fmt.Sprintf("%s[%d]", name, j), tags[i+1:]); err != nil {
+                    return err
+                }
+            }
+            return nil
+        default:
+            if err := applyPlaygroundTag(f, rv.Kind(), tagName, param); err != nil {
+                return err
+            }
+        }
+    }
+
+    if isNestedStruct(rv) {
+        return v.scoped(name).registerTags(rv)
+    }
+    return nil
+}
+
+// applyPlaygroundTag registers the rule named by a single tag on f,
+// whose value has the given kind.
+func applyPlaygroundTag(f *Field, kind reflect.Kind, name string, param string) error {
+    switch name {
+    case "required":
+        f.Required()
+        return nil
+    case "email":
+        f.Email()
+        return nil
+    case "url":
+        f.Url()
+        return nil
+    case "uuid":
+        f.UUID()
+        return nil
+    case "oneof":
+        var values []interface{}
+        for _, option := range strings.Fields(param) {
+            if isNumberKind(kind) {
+                n, err := strconv.ParseFloat(option, 64)
+                if err != nil {
+                    return fmt.Errorf("validator: validate tag \"oneof\" on field %s has a non-numeric option %q", f.name, option)
+                }
+                values = append(values, n)
+                continue
+            }
+            values = append(values, option)
+        }
+        f.OneOf(values)
+        return nil
+    case "eq", "ne":
+        if kind == reflect.String {
+            equal := name == "eq"
+            f.addRule(name, []interface{}{param}, func(f *Field) error {
+                if (f.value == param) != equal {
+                    if equal {
+                        return fmt.Errorf("%s must be equal to %s", f.name, param)
+                    }
+                    return fmt.Errorf("%s must not be equal to %s", f.name, param)
+                }
+                return nil
+            })
+            return nil
+        }
+    case "min", "max", "len", "gt", "gte", "lt", "lte":
+    default:
+        return fmt.Errorf("validator: unsupported validate tag %q on field %s", name, f.name)
+    }
+
+    bound, err := strconv.ParseFloat(param, 64)
+    if err != nil {
+        return fmt.Errorf("validator: validate tag %q on field %s needs a numeric parameter, got %q", name, f.name, param)
+    }
+
+    // min and max measure like gte and lte: strings in characters, as
+    // go-playground does, not in bytes like MinLength and MaxLength.
+    whole := bound == float64(int(bound))
+    switch {
+    case name == "min" && kind == reflect.String && whole:
+        f.MinRunes(int(bound))
+        return nil
+    case name == "max" && kind == reflect.String && whole:
+        f.MaxRunes(int(bound))
+        return nil
+    case name == "min" && isCollectionKind(kind) && whole:
+        f.MinItems(int(bound))
+        return nil
+    case name == "max" && isCollectionKind(kind) && whole:
+        f.MaxItems(int(bound))
+        return nil
+    }
+
+    f.addRule(name, []interface{}{bound}, func(f *Field) error {
+        measure, unit, ok := tagMeasure(f.value)
+        if !ok {
+            return fmt.Errorf("%s cannot be compared", f.name)
+        }
+
+        var failed bool
+        var phrase string
+        switch name {
+        case "min", "gte":
+            failed, phrase = measure < bound, "at least"
+        case "max", "lte":
+            failed, phrase = measure > bound, "at most"
+        case "gt":
+            failed, phrase = measure <= bound, "more than"
+        case "lt":
+            failed, phrase = measure >= bound, "less than"
+        case "len", "eq":
+            failed, phrase = measure != bound, "exactly"
+        case "ne":
+            failed, phrase = measure == bound, "anything but"
+        }
+
+        if failed {
+            if unit == "" {
+                return fmt.Errorf("%s must be %s %v", f.name, phrase, bound)
+            }
+            return fmt.Errorf("%s must be %s %v %s", f.name, phrase, bound, unit)
+        }
+        return nil
+    })
+    return nil
+}
+
+// tagMeasure returns what go-playground style comparisons look at:
+// the character count of a string, the item count of a collection,
+// or the value of a number, with the unit used in error messages.
+func tagMeasure(value interface{}) (float64, string, bool) {
+    if str, ok := value.(string); ok {
+        return float64(utf8.RuneCountInString(str)), "characters", true
+    }
+    if n, ok := toFloat64(value); ok {
+        return n, "", true
+    }
+    if value != nil && isCollectionKind(reflect.ValueOf(value).Kind()) {
+        return float64(reflect.ValueOf(value).Len()), "items", true
+    }
+    return 0, "", false
+}
+
+// splitTag splits a tag on commas, dropping empty entries.
+func splitTag(tag string) []string {
+    var tags []string
+    for _, part := range strings.Split(tag, ",") {
+        if part = strings.TrimSpace(part); part != "" {
+            tags = append(tags, part)
+        }
+    }
+    return tags
+}
+
+// isNestedStruct reports whether rv holds a struct whose fields should be
+// visited. time.Time is treated as a value, not a nested struct.
+func isNestedStruct(rv reflect.Value) bool {
+    for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+        if rv.IsNil() {
+            return false
+        }
+        rv = rv.Elem()
+    }
+    return rv.Kind() == reflect.Struct && rv.Type() != reflect.TypeOf(time.Time{})
+}
+
+// isNumberKind reports whether kind is an integer or float kind.
+func isNumberKind(kind reflect.Kind) bool {
+    switch kind {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+        reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+        reflect.Float32, reflect.Float64:
+        return true
+    }
+    return false
+}
+
+// isCollectionKind reports whether kind is a slice, array, or map.
+func isCollectionKind(kind reflect.Kind) bool {
+    return kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map
+}
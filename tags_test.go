@@ -0,0 +1,63 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestStructTagsMeasureCharacters(t *testing.T) {
+    type profile struct {
+        Name string `validate:"min=2,max=5"`
+        Bio  string `validate:"gte=2,lte=5"`
+        Age  int    `validate:"min=18,max=120"`
+    }
+
+    tests := []struct {
+        value   profile
+        message string
+    }{
+        {profile{Name: "ééééé", Bio: "ééééé", Age: 30}, ""},
+        {profile{Name: "éééééé", Bio: "ééééé", Age: 30}, "Name cannot be longer than 5 characters"},
+        {profile{Name: "é", Bio: "ééééé", Age: 30}, "Name must be at least 2 characters long"},
+        {profile{Name: "ééééé", Bio: "éééééé", Age: 30}, "Bio must be at most 5 characters"},
+        {profile{Name: "ééééé", Bio: "ééééé", Age: 17}, "Age must be at least 18"},
+        {profile{Name: "ééééé", Bio: "ééééé", Age: 121}, "Age must be at most 120"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        if err := v.StructTags(tt.value); err != nil {
+            t.Fatal(err)
+        }
+        if got := firstError(v); got != tt.message {
+            t.Errorf("%+v: got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+}
+
+func TestStructTagsOmitEmpty(t *testing.T) {
+    type signup struct {
+        Email    string `validate:"required,email"`
+        Referrer string `validate:"omitempty,email"`
+        Age      int    `validate:"omitempty,gte=18"`
+    }
+
+    v := New().StrictFields(true)
+    if err := v.StructTags(signup{Email: "ada@example.com"}); err != nil {
+        t.Fatal(err)
+    }
+    res := v.ValidateN(0)
+    if !res.Valid() {
+        t.Errorf("empty omitempty fields reported: %v", res.Errors())
+    }
+    if res.HasField("Referrer") || res.HasField("Age") {
+        t.Error("empty omitempty fields are registered")
+    }
+
+    v = New().StrictFields(true)
+    if err := v.StructTags(signup{Email: "ada@example.com", Referrer: "bob", Age: 16}); err != nil {
+        t.Fatal(err)
+    }
+    if errs := v.Validate(false); len(errs) != 2 {
+        t.Errorf("got errors %v, want Referrer and Age", errs)
+    }
+}
@@ -0,0 +1,231 @@
+package validator
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// htmlTagPattern matches the start of anything a browser could parse as
+// markup: "<" followed by a letter (a tag), "/" (a closing tag), "!"
+// (a comment or doctype) or "?" (a processing instruction).
+var htmlTagPattern = regexp.MustCompile(`<[a-zA-Z/!?]`)
+
+// scriptTagPattern matches opening or closing tags of elements that can
+// run code or load content.
+var scriptTagPattern = regexp.MustCompile(`(?i)<\s*/?\s*(script|style|iframe|object|embed)\b`)
+
+// eventHandlerPattern matches an event handler attribute such as
+// onclick= inside a tag.
+var eventHandlerPattern = regexp.MustCompile(`(?i)<[^>]*[\s/"']on[a-z]+\s*=`)
+
+// NoHTML validates that a string contains no HTML, for plain text fields
+// such as bios and comments. It fails when "<" is directly followed by a
+// letter, "/", "!" or "?", whether or not the tag is closed, and it also
+// checks the text with HTML entities decoded, so "&lt;script&gt;" fails too.
+//
+// This is deliberately strict: text such as "x<y" is rejected, while
+// "a < b" (with a space) is accepted. Use NoScriptTags when only
+// dangerous markup needs to be blocked.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NoHTML()
+//    f.NoHTML("Bio must be plain text")
+func (f *Field) NoHTML(messages ...string) *Field {
+    f.addRule("noHTML", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || htmlTagPattern.MatchString(str) || htmlTagPattern.MatchString(html.UnescapeString(str)) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain HTML", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// NoScriptTags validates that a string contains no script, style, iframe,
+// object or embed tags and no event handler attributes such as onclick=,
+// also after decoding HTML entities. Other markup and plain comparisons
+// like "a < b" are allowed. It is a softer alternative to NoHTML, not a
+// replacement for escaping output.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NoScriptTags()
+//    f.NoScriptTags("Comment contains disallowed markup")
+func (f *Field) NoScriptTags(messages ...string) *Field {
+    f.addRule("noScriptTags", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok {
+            decoded := html.UnescapeString(str)
+            for _, text := range []string{str, decoded} {
+                if scriptTagPattern.MatchString(text) || eventHandlerPattern.MatchString(text) {
+                    ok = false
+                    break
+                }
+            }
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s cannot contain scripts or event handlers", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// BannedWordOptions configures how NotContainsAnyWith matches words.
+// Matching is always case-insensitive.
+type BannedWordOptions struct {
+    // Substring matches words anywhere, even inside other words.
+    // By default only whole words (and whole phrases) match.
+    Substring bool
+    // StripPunctuation removes everything but letters, digits and spaces
+    // before matching, so "b-a-d" matches "bad".
+    StripPunctuation bool
+    // CollapseRepeats reduces runs of the same character to one, on both
+    // the text and the words, so "baaad" matches "bad". It can cause false
+    // positives, since "good" then also matches "god".
+    CollapseRepeats bool
+}
+
+var (
+    bannedWordsMu sync.RWMutex
+    bannedWords   []string
+)
+
+// RegisterBannedWords adds words that every NotContainsAny rule checks in
+// addition to its own list, for app-wide defaults. It is safe for
+// concurrent use and typically called once at startup.
+//
+// Example:
+//
+//    validator.RegisterBannedWords(loadBannedWords()...)
+func RegisterBannedWords(words ...string) {
+    bannedWordsMu.Lock()
+    defer bannedWordsMu.Unlock()
+    bannedWords = append(bannedWords, words...)
+}
+
+// registeredBannedWords returns a copy of the app-wide banned words.
+func registeredBannedWords() []string {
+    bannedWordsMu.RLock()
+    defer bannedWordsMu.RUnlock()
+    return append([]string(nil), bannedWords...)
+}
+
+// NotContainsAny validates that a string contains none of `words`, nor any
+// word registered with RegisterBannedWords, as whole words and ignoring case.
+// The error does not repeat the matched word.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NotContainsAny([]string{"admin", "root"})
+//    f.NotContainsAny(nil, "Display name is not allowed")
+func (f *Field) NotContainsAny(words []string, messages ...string) *Field {
+    return f.NotContainsAnyWith(words, BannedWordOptions{}, messages...)
+}
+
+// NotContainsAnyWith is NotContainsAny with matching options, such as
+// substring matching and normalization against evasion like "b-a-d".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.NotContainsAnyWith(words, validator.BannedWordOptions{StripPunctuation: true, CollapseRepeats: true})
+func (f *Field) NotContainsAnyWith(words []string, opts BannedWordOptions, messages ...string) *Field {
+    f.addRule("notContainsAny", []interface{}{len(words)}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok && containsBannedWord(str, append(registeredBannedWords(), words...), opts) {
+            ok = false
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s contains a word that is not allowed", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+// containsBannedWord reports whether text contains one of words.
+func containsBannedWord(text string, words []string, opts BannedWordOptions) bool {
+    normalized := normalizeWords(text, opts)
+    if !opts.Substring {
+        normalized = " " + normalized + " "
+    }
+
+    for _, word := range words {
+        word = normalizeWords(word, opts)
+        if word == "" {
+            continue
+        }
+        if !opts.Substring {
+            word = " " + word + " "
+        }
+        if strings.Contains(normalized, word) {
+            return true
+        }
+    }
+    return false
+}
+
+// normalizeWords lower-cases text and splits it into words separated by
+// single spaces, applying the normalization options.
+func normalizeWords(text string, opts BannedWordOptions) string {
+    text = strings.ToLower(text)
+
+    if opts.StripPunctuation {
+        text = strings.Map(func(r rune) rune {
+            if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+                return r
+            }
+            return -1
+        }, text)
+    }
+
+    words := strings.FieldsFunc(text, func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+    })
+
+    if opts.CollapseRepeats {
+        for i, word := range words {
+            var b strings.Builder
+            var last rune = -1
+            for _, r := range word {
+                if r != last {
+                    b.WriteRune(r)
+                }
+                last = r
+            }
+            words[i] = b.String()
+        }
+    }
+    return strings.Join(words, " ")
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestHTMLPayloads(t *testing.T) {
+    tests := []struct {
+        value              string
+        noHTML, noScripts bool
+    }{
+        // Plain text.
+        {"Hello, world", true, true},
+        {"a < b and b > c", true, true},
+        {"5 <= 6", true, true},
+        {"<3", true, true},
+        {"Tom & Jerry", true, true},
+
+        // Harmless markup only NoHTML rejects.
+        {"<b>bold</b>", false, true},
+        {"x<y", false, true},
+        {"<!-- comment -->", false, true},
+        {"</p>", false, true},
+        {"&lt;b&gt;bold&lt;/b&gt;", false, true},
+
+        // Scripts, also entity-encoded or unclosed.
+        {"<script>alert(1)</script>", false, false},
+        {"<SCRIPT SRC=//evil.example/x.js>", false, false},
+        // Browsers don't parse "< script" as a tag, but NoScriptTags
+        // blocks it anyway.
+        {"< script>alert(1)", true, false},
+        {"</script ><script>alert(1)", false, false},
+        {"&lt;script&gt;alert(1)&lt;/script&gt;", false, false},
+        {"&#60;script&#62;alert(1)", false, false},
+        {"&#x3C;iframe src=javascript:alert(1)>", false, false},
+        {"<iframe src=\"https://evil.example\"></iframe>", false, false},
+        {"<object data=x.swf>", false, false},
+        {"<embed src=x.swf>", false, false},
+        {"<style>body{display:none}</style>", false, false},
+
+        // Event handler attributes.
+        {"<img src=x onerror=alert(1)>", false, false},
+        {"<svg/onload=alert(1)>", false, false},
+        {"<a href=# ONCLICK = \"alert(1)\">x</a>", false, false},
+        {"<div title=\"x\"onmouseover=alert(1)>", false, false},
+        {"&lt;img src=x onerror=alert(1)&gt;", false, false},
+        {"Turn it on=off", true, true},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Bio").NoHTML()
+        if valid := v.Validate(false) == nil; valid != tt.noHTML {
+            t.Errorf("NoHTML(%q): valid = %v, want %v", tt.value, valid, tt.noHTML)
+        }
+
+        v = New()
+        v.Field(tt.value, "Bio").NoScriptTags()
+        if valid := v.Validate(false) == nil; valid != tt.noScripts {
+            t.Errorf("NoScriptTags(%q): valid = %v, want %v", tt.value, valid, tt.noScripts)
+        }
+    }
+}
+
+func TestHTMLMessages(t *testing.T) {
+    v := New()
+    v.Field("<b>hi</b>", "Bio").NoHTML()
+    if got, want := firstError(v), "Bio cannot contain HTML"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+
+    v = New()
+    v.Field("<script>", "Comment").NoScriptTags("Comment contains disallowed markup")
+    if got, want := firstError(v), "Comment contains disallowed markup"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
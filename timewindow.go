@@ -0,0 +1,167 @@
+package validator
+
+import (
+    "fmt"
+    "time"
+)
+
+// TimeWindowOpts configures Validator.TimeWindow.
+type TimeWindowOpts struct {
+    // MinDuration, when not 0, is the shortest window accepted.
+    MinDuration time.Duration
+    // MaxDuration, when not 0, is the longest window accepted. A
+    // MaxDuration below MinDuration is a *ConfigError.
+    MaxDuration time.Duration
+    // Tolerance is how far the duration may be from the time between the
+    // start and the end when all three are sent, such as time.Minute for
+    // forms that round. It is 0, an exact match, by default. A negative
+    // tolerance is a *ConfigError.
+    Tolerance time.Duration
+}
+
+// TimeWindow registers a time window, such as a booking or a maintenance
+// window, sent as any two of its start, end and duration, and checks that
+// they agree:
+//   - at least two of the three must be sent,
+//   - the end must be after the start,
+//   - when all three are sent, the duration must be the time between the
+//     start and the end, give or take opts.Tolerance,
+//   - the window must last at least opts.MinDuration and at most
+//     opts.MaxDuration, when they are set.
+//
+// start and end may be time.Time, *time.Time or RFC 3339 strings, and
+// duration a time.Duration, a Go duration string such as "1h30m", or an
+// ISO 8601 duration such as "PT1H30M" or "P1D", where a day is 24 hours.
+// ISO durations with years or months are rejected, since their length
+// depends on the start. nil, "", the zero time and a zero duration count
+// as not sent. Values that don't parse are reported by fields scoped to
+// `name`, as in "Maintenance Window.End must be an RFC 3339 timestamp",
+// and the checks of the window by a field named `name`, which is
+// returned, for WithCode or Warn.
+//
+// Example:
+//    v.TimeWindow(req.Start, req.End, req.Duration, "Maintenance Window", validator.TimeWindowOpts{
+//        MinDuration: 15 * time.Minute,
+//        MaxDuration: 8 * time.Hour,
+//    })
+//    // Maintenance Window must end after it starts
+func (v *Validator) TimeWindow(start, end, duration interface{}, name string, opts TimeWindowOpts) *Field {
+    v.Scope(name, func(sv *Validator) {
+        sv.Field(start, "Start").Optional().addRule("timeWindow", nil, func(f *Field) error {
+            _, _, err := windowTime(f.value, f.name)
+            return err
+        })
+        sv.Field(end, "End").Optional().addRule("timeWindow", nil, func(f *Field) error {
+            _, _, err := windowTime(f.value, f.name)
+            return err
+        })
+        sv.Field(duration, "Duration").Optional().addRule("timeWindow", nil, func(f *Field) error {
+            _, _, err := windowDuration(f.value, f.name)
+            return err
+        })
+    })
+
+    f := v.CheckComputed(name, func() error {
+        startTime, hasStart, startErr := windowTime(start, "")
+        endTime, hasEnd, endErr := windowTime(end, "")
+        length, hasDuration, durationErr := windowDuration(duration, "")
+        if startErr != nil || endErr != nil || durationErr != nil {
+            // Already reported by the field that failed to parse.
+            return nil
+        }
+
+        sent := 0
+        for _, has := range []bool{hasStart, hasEnd, hasDuration} {
+            if has {
+                sent++
+            }
+        }
+        if sent < 2 {
+            return fmt.Errorf("%s needs at least two of a start, an end and a duration", name)
+        }
+
+        if hasStart && hasEnd {
+            if !endTime.After(startTime) {
+                return fmt.Errorf("%s must end after it starts", name)
+            }
+            between := endTime.Sub(startTime)
+            if hasDuration {
+                diff := length - between
+                if diff < 0 {
+                    diff = -diff
+                }
+                if diff > opts.Tolerance {
+                    return fmt.Errorf("%s has a duration of %v, but its start and end are %v apart", name, length, between)
+                }
+            }
+            length = between
+        } else if length <= 0 {
+            return fmt.Errorf("%s must have a positive duration", name)
+        }
+
+        if opts.MinDuration > 0 && length < opts.MinDuration {
+            return fmt.Errorf("%s must last at least %v", name, opts.MinDuration)
+        }
+        if opts.MaxDuration > 0 && length > opts.MaxDuration {
+            return fmt.Errorf("%s must last at most %v", name, opts.MaxDuration)
+        }
+        return nil
+    })
+
+    var err error
+    switch {
+    case opts.Tolerance < 0:
+        err = fmt.Errorf("tolerance %v is negative", opts.Tolerance)
+    case opts.MaxDuration > 0 && opts.MaxDuration < opts.MinDuration:
+        err = fmt.Errorf("minimum duration %v is greater than maximum duration %v", opts.MinDuration, opts.MaxDuration)
+    }
+    return f.misconfigured(err)
+}
+
+// windowTime returns the time of a TimeWindow start or end, and whether it
+// was sent. Errors are about the field named `name`.
+func windowTime(value interface{}, name string) (time.Time, bool, error) {
+    if t, ok := timeValue(value); ok {
+        return t, !t.IsZero(), nil
+    }
+    switch value := value.(type) {
+    case nil:
+        return time.Time{}, false, nil
+    case *time.Time:
+        return time.Time{}, false, nil
+    case string:
+        if value == "" {
+            return time.Time{}, false, nil
+        }
+        t, err := time.Parse(time.RFC3339Nano, value)
+        if err != nil {
+            return time.Time{}, false, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+        }
+        return t, true, nil
+    }
+    return time.Time{}, false, fmt.Errorf("%s must be a time", name)
+}
+
+// windowDuration returns the duration of a TimeWindow, and whether it was
+// sent. Errors are about the field named `name`.
+func windowDuration(value interface{}, name string) (time.Duration, bool, error) {
+    switch value := value.(type) {
+    case nil:
+        return 0, false, nil
+    case time.Duration:
+        return value, value != 0, nil
+    case string:
+        if value == "" {
+            return 0, false, nil
+        }
+        if d, ok := isoDurationValue(value); ok {
+            return d, true, nil
+        }
+        d, err := time.ParseDuration(value)
+        if err != nil {
+            return 0, false, fmt.Errorf("%s must be a duration such as 1h30m or PT1H30M", name)
+        }
+        return d, true, nil
+    }
+    return 0, false, fmt.Errorf("%s must be a duration", name)
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+    "testing"
+    "time"
+)
+
+func TestTimeWindow(t *testing.T) {
+    start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+    end := start.Add(2 * time.Hour)
+    opts := TimeWindowOpts{MinDuration: 15 * time.Minute, MaxDuration: 8 * time.Hour, Tolerance: time.Minute}
+
+    tests := []struct {
+        start, end, duration interface{}
+        message              string
+    }{
+        {start, end, nil, ""},
+        {start, nil, 2 * time.Hour, ""},
+        {nil, end, "2h", ""},
+        {"2026-03-01T09:00:00Z", "2026-03-01T11:00:00Z", "PT2H", ""},
+        {start, end, "PT2H", ""},
+        {start, end, "PT2H0M30S", ""},
+        {start, nil, "PT1H30M", ""},
+        {start, nil, "P1D", "Window must last at most 8h0m0s"},
+        {start, end, "PT3H", "Window has a duration of 3h0m0s, but its start and end are 2h0m0s apart"},
+        {start, nil, "P1M", "Window.Duration must be a duration such as 1h30m or PT1H30M"},
+        {start, nil, "two hours", "Window.Duration must be a duration such as 1h30m or PT1H30M"},
+        {start, nil, nil, "Window needs at least two of a start, an end and a duration"},
+        {end, start, nil, "Window must end after it starts"},
+        {start, start.Add(5 * time.Minute), nil, "Window must last at least 15m0s"},
+        {"yesterday", end, nil, "Window.Start must be an RFC 3339 timestamp"},
+    }
+
+    for i, tt := range tests {
+        v := New()
+        v.TimeWindow(tt.start, tt.end, tt.duration, "Window", opts)
+        if got := firstError(v); got != tt.message {
+            t.Errorf("case %d: got %q, want %q", i, got, tt.message)
+        }
+    }
+}
+
+func TestTimeWindowOpts(t *testing.T) {
+    for _, opts := range []TimeWindowOpts{
+        {Tolerance: -time.Second},
+        {MinDuration: time.Hour, MaxDuration: time.Minute},
+    } {
+        v := New()
+        v.TimeWindow(nil, nil, nil, "Window", opts)
+        if v.Err() == nil {
+            t.Errorf("%+v is not a configuration error", opts)
+        }
+    }
+}
@@ -0,0 +1,276 @@
+package validator
+
+import "fmt"
+
+// Number is the set of types accepted by NumberField.
+type Number interface {
+    ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+        ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+        ~float32 | ~float64
+}
+
+// NumberField is a field holding a number of type T. It only offers rules
+// that make sense for numbers, so mistakes such as calling Email on an age
+// are caught by the compiler. Its errors go to the Validator it was
+// registered on, like those of any other field.
+type NumberField[T Number] struct {
+    field *Field
+}
+
+// StringField is a field holding a string. It only offers rules that make
+// sense for strings.
+type StringField struct {
+    field *Field
+}
+
+// TypedField is a field holding a value of type T: *NumberField[T] for
+// the numbers and *StringField for strings. Go methods can't depend on T,
+// so the rules stay on those types, which keeps Email on an int a compile
+// error, while code shared between typed fields, such as a helper reading
+// the checked value, accepts a TypedField[T].
+//
+// Example:
+//
+//    func saveAge(age validator.TypedField[int]) {
+//        db.SetAge(age.Value())
+//    }
+type TypedField[T any] interface {
+    // Field returns the underlying dynamic field.
+    Field() *Field
+    // Value returns the value, after the conversions of the rules that ran.
+    Value() T
+}
+
+var (
+    _ TypedField[int]    = (*NumberField[int])(nil)
+    _ TypedField[string] = (*StringField)(nil)
+)
+
+// Num registers a number field of any numeric type on v.
+// Use the dynamic Field for values whose type is only known at run time.
+//
+// Example:
+//
+//    validator.Num(v, order.Quantity, "Quantity").Min(1).Max(99)
+func Num[T Number](v *Validator, value T, name string) *NumberField[T] {
+    return &NumberField[T]{field: v.Field(value, name)}
+}
+
+// Int registers an int field on v.
+//
+// Example:
+//
+//    validator.Int(v, user.Age, "Age").Min(18).Max(120)
+func Int(v *Validator, value int, name string) *NumberField[int] {
+    return Num(v, value, name)
+}
+
+// Float registers a float64 field on v.
+//
+// Example:
+//
+//    validator.Float(v, item.Price, "Price").Min(0.01)
+func Float(v *Validator, value float64, name string) *NumberField[float64] {
+    return Num(v, value, name)
+}
+
+// Str registers a string field on v.
+//
+// Example:
+//
+//    validator.Str(v, user.Name, "Name").Required().MinLength(2)
+func Str(v *Validator, value string, name string) *StringField {
+    return &StringField{field: v.Field(value, name)}
+}
+
+// Field returns the underlying dynamic field, for rules the typed field
+// doesn't offer.
+func (n *NumberField[T]) Field() *Field {
+    return n.field
+}
+
+// Value returns the number, after the conversions of the rules that ran.
+func (n *NumberField[T]) Value() T {
+    value, _ := n.field.value.(T)
+    return value
+}
+
+// Required ensures the number is not zero.
+func (n *NumberField[T]) Required() *NumberField[T] {
+    n.field.Required()
+    return n
+}
+
+// Min checks that the number is not less than `min`.
+// Accepts an optional custom error message.
+func (n *NumberField[T]) Min(min T, messages ...string) *NumberField[T] {
+    n.field.addRule("min", []interface{}{min}, func(f *Field) error {
+        if value, _ := f.value.(T); value < min {
+            return typedError(messages, "%v cannot be less than %v", f.value, min)
+        }
+        return nil
+    })
+    return n
+}
+
+// Max checks that the number does not exceed `max`.
+// Accepts an optional custom error message.
+func (n *NumberField[T]) Max(max T, messages ...string) *NumberField[T] {
+    n.field.addRule("max", []interface{}{max}, func(f *Field) error {
+        if value, _ := f.value.(T); value > max {
+            return typedError(messages, "%v cannot be greater than %v", f.value, max)
+        }
+        return nil
+    })
+    return n
+}
+
+// OneOf checks that the number is one of `values`.
+func (n *NumberField[T]) OneOf(values ...T) *NumberField[T] {
+    allowed := make([]interface{}, len(values))
+    for i, value := range values {
+        allowed[i] = value
+    }
+    n.field.OneOf(allowed)
+    return n
+}
+
+// Custom validates the number with a user supplied function, reporting
+// the error it returns.
+// Accepts an optional custom error message, used instead of the returned error.
+func (n *NumberField[T]) Custom(fn func(value T) error, messages ...string) *NumberField[T] {
+    n.field.Custom(func(value interface{}) error {
+        typed, _ := value.(T)
+        return fn(typed)
+    }, messages...)
+    return n
+}
+
+// Description attaches a human readable description to the field.
+func (n *NumberField[T]) Description(text string) *NumberField[T] {
+    n.field.Description(text)
+    return n
+}
+
+// Field returns the underlying dynamic field, for rules the typed field
+// doesn't offer.
+func (s *StringField) Field() *Field {
+    return s.field
+}
+
+// Value returns the string, after the conversions of the rules that ran,
+// such as Trim.
+func (s *StringField) Value() string {
+    value, _ := s.field.value.(string)
+    return value
+}
+
+// Required ensures the string is not empty.
+func (s *StringField) Required() *StringField {
+    s.field.Required()
+    return s
+}
+
+// Sensitive keeps the string out of errors and logs. See Field.Sensitive.
+func (s *StringField) Sensitive() *StringField {
+    s.field.Sensitive()
+    return s
+}
+
+// RequiredNonBlank ensures the string is not empty or only white space.
+// Accepts an optional custom error message.
+func (s *StringField) RequiredNonBlank(messages ...string) *StringField {
+    s.field.RequiredNonBlank(messages...)
+    return s
+}
+
+// Trim removes leading and trailing white space before the rules after it.
+func (s *StringField) Trim() *StringField {
+    s.field.Trim()
+    return s
+}
+
+// MinLength checks that the string is at least `length` bytes long.
+// Accepts an optional custom error message.
+func (s *StringField) MinLength(length int, messages ...string) *StringField {
+    s.field.MinLength(length, messages...)
+    return s
+}
+
+// MaxLength checks that the string is at most `length` bytes long.
+// Accepts an optional custom error message.
+func (s *StringField) MaxLength(length int, messages ...string) *StringField {
+    s.field.MaxLength(length, messages...)
+    return s
+}
+
+// Email checks that the string is an email address.
+// Accepts an optional custom error message.
+func (s *StringField) Email(messages ...string) *StringField {
+    s.field.Email(messages...)
+    return s
+}
+
+// Url checks that the string is a URL.
+// Accepts an optional custom error message.
+func (s *StringField) Url(messages ...string) *StringField {
+    s.field.Url(messages...)
+    return s
+}
+
+// UUID checks that the string is a UUID.
+// Accepts an optional custom error message.
+func (s *StringField) UUID(messages ...string) *StringField {
+    s.field.UUID(messages...)
+    return s
+}
+
+// Matches checks that the string matches the regular expression `pattern`.
+// Accepts an optional custom error message.
+func (s *StringField) Matches(pattern string, messages ...string) *StringField {
+    s.field.Matches(pattern, messages...)
+    return s
+}
+
+// Normalize rewrites the string into the Unicode normalization `form`
+// before the rules after it run.
+func (s *StringField) Normalize(form string) *StringField {
+    s.field.Normalize(form)
+    return s
+}
+
+// OneOf checks that the string is one of `values`.
+func (s *StringField) OneOf(values ...string) *StringField {
+    allowed := make([]interface{}, len(values))
+    for i, value := range values {
+        allowed[i] = value
+    }
+    s.field.OneOf(allowed)
+    return s
+}
+
+// Custom validates the string with a user supplied function, reporting
+// the error it returns.
+// Accepts an optional custom error message, used instead of the returned error.
+func (s *StringField) Custom(fn func(value string) error, messages ...string) *StringField {
+    s.field.Custom(func(value interface{}) error {
+        str, _ := value.(string)
+        return fn(str)
+    }, messages...)
+    return s
+}
+
+// Description attaches a human readable description to the field.
+func (s *StringField) Description(text string) *StringField {
+    s.field.Description(text)
+    return s
+}
+
+// typedError returns the custom message if one was given, or the
+// formatted default message.
+func typedError(messages []string, format string, args ...interface{}) error {
+    if len(messages) > 0 && messages[0] != "" {
+        return fmt.Errorf("%s", messages[0])
+    }
+    return fmt.Errorf(format, args...)
+}
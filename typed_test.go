@@ -0,0 +1,44 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestTypedMinMaxMessages(t *testing.T) {
+    tests := []struct {
+        age int
+        min int
+        max int
+    }{
+        {15, 18, 120},
+        {130, 18, 120},
+        {30, 18, 120},
+    }
+
+    for _, tt := range tests {
+        typed := New()
+        Int(typed, tt.age, "Age").Min(tt.min).Max(tt.max)
+        dynamic := New()
+        dynamic.Field(tt.age, "Age").Min(tt.min).Max(tt.max)
+
+        if got, want := firstError(typed), firstError(dynamic); got != want {
+            t.Errorf("age %d: typed error %q, dynamic error %q", tt.age, got, want)
+        }
+    }
+}
+
+func TestTypedFieldValue(t *testing.T) {
+    v := New()
+    var age TypedField[int] = Int(v, 42, "Age").Min(18)
+    var name TypedField[string] = Str(v, "  Ada ", "Name").Trim().Required()
+
+    if errs := v.Validate(false); errs != nil {
+        t.Fatalf("unexpected errors: %v", errs)
+    }
+    if age.Value() != 42 {
+        t.Errorf("age = %d, want 42", age.Value())
+    }
+    if name.Value() != "Ada" {
+        t.Errorf("name = %q, want %q", name.Value(), "Ada")
+    }
+}
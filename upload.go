@@ -0,0 +1,285 @@
+package validator
+
+import (
+    "encoding/binary"
+    "fmt"
+    "image"
+    _ "image/gif"  // register GIF for image.DecodeConfig
+    _ "image/jpeg" // register JPEG for image.DecodeConfig
+    _ "image/png"  // register PNG for image.DecodeConfig
+    "io"
+    "mime/multipart"
+    "net/http"
+    "strconv"
+    "strings"
+)
+
+// sniffLength is the number of bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+// Upload is an uploaded file given as a reader and its size, for files
+// that don't come from a multipart form, such as an object in storage.
+type Upload struct {
+    Reader io.ReaderAt
+    Size   int64
+}
+
+// File registers an uploaded file for the upload rules MaxFileSize,
+// MinFileSize and ImageDimensions. The value may be a
+// *multipart.FileHeader, an Upload or a *Upload. A nil value is empty,
+// so Required reports a missing file.
+//
+// Example:
+//
+//    _, header, _ := r.FormFile("avatar")
+//    v.File(header, "Avatar").Required().MaxFileSize(2 << 20).ImageDimensions(100, 100, 4096, 4096)
+func (v *Validator) File(file interface{}, name string) *Field {
+    if header, ok := file.(*multipart.FileHeader); ok && header == nil {
+        file = nil
+    }
+    if upload, ok := file.(*Upload); ok && upload == nil {
+        file = nil
+    }
+    return v.Field(file, name)
+}
+
+// FormFile registers the first file uploaded under `key` in a multipart
+// request body. A missing file is validated as nil, and a body that
+// cannot be parsed makes the field report an error.
+//
+// Example:
+//
+//    b.FormFile("avatar", "Avatar").Required().MaxFileSize(2 << 20)
+func (b *RequestValidator) FormFile(key string, name string) *Field {
+    if err := b.parseForm(); err != nil {
+        f := b.Field(nil, name)
+        f.key = key
+        f.addRule("form", nil, func(f *Field) error {
+            return fmt.Errorf("%s could not be read: %v", f.name, err)
+        })
+        return f
+    }
+
+    var file interface{}
+    if form := b.request.MultipartForm; form != nil && len(form.File[key]) > 0 {
+        file = form.File[key][0]
+    }
+    f := b.File(file, name)
+    f.key = key
+    return f
+}
+
+// MaxFileSize validates that an uploaded file (see File) is at most
+// `bytes` long. The size is taken from the upload, without reading it.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MaxFileSize(2 << 20)
+//    f.MaxFileSize(2 << 20, "Avatar must be 2 MB or less")
+func (f *Field) MaxFileSize(bytes int64, messages ...string) *Field {
+    f.addRule("maxFileSize", []interface{}{bytes}, func(f *Field) error {
+        return checkFileSize(f, messages, -1, bytes)
+    })
+    return f
+}
+
+// MinFileSize validates that an uploaded file (see File) is at least
+// `bytes` long, for example to reject empty uploads.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.MinFileSize(1)
+func (f *Field) MinFileSize(bytes int64, messages ...string) *Field {
+    f.addRule("minFileSize", []interface{}{bytes}, func(f *Field) error {
+        return checkFileSize(f, messages, bytes, -1)
+    })
+    return f
+}
+
+// checkFileSize checks the size of the upload in f against the bounds
+// that are not negative.
+func checkFileSize(f *Field, messages []string, min, max int64) error {
+    message := ""
+    if len(messages) > 0 {
+        message = messages[0]
+    }
+
+    size, ok := uploadSize(f.value)
+    var err error
+    switch {
+    case !ok:
+        err = fmt.Errorf("%s must be an uploaded file", f.name)
+    case max >= 0 && size > max:
+        err = fmt.Errorf("%s must not be larger than %s", f.name, formatBytes(max))
+    case min >= 0 && size < min:
+        err = fmt.Errorf("%s must not be smaller than %s", f.name, formatBytes(min))
+    }
+
+    if err != nil && message != "" {
+        return fmt.Errorf("%s", message)
+    }
+    return err
+}
+
+// ImageDimensions validates that an uploaded file (see File) is a PNG,
+// JPEG, GIF or WebP image whose width is between minW and maxW and whose
+// height is between minH and maxH pixels. The type is detected from the
+// content with http.DetectContentType, never from the Content-Type sent
+// by the client, and only the image header is read.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.ImageDimensions(100, 100, 4096, 4096)
+func (f *Field) ImageDimensions(minW, minH, maxW, maxH int, messages ...string) *Field {
+    f.addRule("imageDimensions", []interface{}{minW, minH, maxW, maxH}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        width, height, err := imageSize(f)
+        if err == nil && (width < minW || height < minH || width > maxW || height > maxH) {
+            err = fmt.Errorf("%s must be between %dx%d and %dx%d pixels, got %dx%d",
+                f.name, minW, minH, maxW, maxH, width, height)
+        }
+
+        if err != nil && message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return err
+    })
+    return f
+}
+
+// imageSize returns the dimensions of the image uploaded in f.
+func imageSize(f *Field) (width, height int, err error) {
+    reader, size, ok := uploadReader(f.value)
+    if !ok {
+        return 0, 0, fmt.Errorf("%s must be an uploaded file", f.name)
+    }
+    if closer, isCloser := reader.(io.Closer); isCloser {
+        defer closer.Close()
+    }
+    if reader == nil {
+        return 0, 0, fmt.Errorf("%s could not be read", f.name)
+    }
+
+    head := make([]byte, sniffLength)
+    n, readErr := reader.ReadAt(head, 0)
+    if readErr != nil && readErr != io.EOF {
+        return 0, 0, fmt.Errorf("%s could not be read: %v", f.name, readErr)
+    }
+    head = head[:n]
+
+    notImage := fmt.Errorf("%s must be a PNG, JPEG, GIF or WebP image", f.name)
+    switch http.DetectContentType(head) {
+    case "image/png", "image/jpeg", "image/gif":
+        config, _, err := image.DecodeConfig(io.NewSectionReader(reader, 0, size))
+        if err != nil {
+            return 0, 0, notImage
+        }
+        return config.Width, config.Height, nil
+    case "image/webp":
+        width, height, ok := webpSize(head)
+        if !ok {
+            return 0, 0, notImage
+        }
+        return width, height, nil
+    }
+    return 0, 0, notImage
+}
+
+// uploadSize returns the size of the upload held in value.
+func uploadSize(value interface{}) (int64, bool) {
+    switch upload := value.(type) {
+    case *multipart.FileHeader:
+        if upload != nil {
+            return upload.Size, true
+        }
+    case Upload:
+        return upload.Size, true
+    case *Upload:
+        if upload != nil {
+            return upload.Size, true
+        }
+    }
+    return 0, false
+}
+
+// uploadReader returns a reader for the upload held in value and its size.
+// Readers opened from a *multipart.FileHeader must be closed by the caller.
+func uploadReader(value interface{}) (reader io.ReaderAt, size int64, ok bool) {
+    switch upload := value.(type) {
+    case *multipart.FileHeader:
+        if upload == nil {
+            return nil, 0, false
+        }
+        file, err := upload.Open()
+        if err != nil {
+            return nil, upload.Size, true
+        }
+        return file, upload.Size, true
+    case Upload:
+        return upload.Reader, upload.Size, true
+    case *Upload:
+        if upload != nil {
+            return upload.Reader, upload.Size, true
+        }
+    }
+    return nil, 0, false
+}
+
+// webpSize reads the canvas size from the header of a WebP file, which
+// the standard library cannot decode, in any of its three encodings.
+func webpSize(head []byte) (width, height int, ok bool) {
+    if len(head) < 30 || string(head[0:4]) != "RIFF" || string(head[8:12]) != "WEBP" {
+        return 0, 0, false
+    }
+
+    switch string(head[12:16]) {
+    case "VP8 ":
+        // Lossy: a frame tag and start code, then 14-bit sizes.
+        if head[23] != 0x9d || head[24] != 0x01 || head[25] != 0x2a {
+            return 0, 0, false
+        }
+        width = int(binary.LittleEndian.Uint16(head[26:28]) & 0x3fff)
+        height = int(binary.LittleEndian.Uint16(head[28:30]) & 0x3fff)
+    case "VP8L":
+        // Lossless: a signature byte, then 14-bit sizes minus one.
+        if head[20] != 0x2f {
+            return 0, 0, false
+        }
+        bits := binary.LittleEndian.Uint32(head[21:25])
+        width = int(bits&0x3fff) + 1
+        height = int(bits>>14&0x3fff) + 1
+    case "VP8X":
+        // Extended: 24-bit canvas sizes minus one.
+        width = int(uint32(head[24])|uint32(head[25])<<8|uint32(head[26])<<16) + 1
+        height = int(uint32(head[27])|uint32(head[28])<<8|uint32(head[29])<<16) + 1
+    default:
+        return 0, 0, false
+    }
+    return width, height, true
+}
+
+// formatBytes formats a size in bytes for error messages, such as
+// "512 bytes", "1.5 KB" or "2 MB", using 1024 as the unit.
+func formatBytes(bytes int64) string {
+    if bytes == 1 {
+        return "1 byte"
+    }
+    if bytes < 1024 {
+        return fmt.Sprintf("%d bytes", bytes)
+    }
+    size := float64(bytes)
+    unit := ""
+    for _, u := range []string{"KB", "MB", "GB", "TB"} {
+        size /= 1024
+        unit = u
+        if size < 1024 {
+            break
+        }
+    }
+    text := strconv.FormatFloat(size, 'f', 1, 64)
+    return strings.TrimSuffix(text, ".0") + " " + unit
+}
@@ -0,0 +1,143 @@
+package validator
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "unicode"
+    "unicode/utf8"
+)
+
+// UsernameCharClass selects which letters and digits a username may use.
+type UsernameCharClass int
+
+const (
+    // UsernameCharsASCII allows the letters a-z and A-Z and the digits 0-9.
+    UsernameCharsASCII UsernameCharClass = iota
+    // UsernameCharsUnicode allows letters and digits from any script.
+    UsernameCharsUnicode
+)
+
+// UsernamePolicy configures the Username rule. Zero values disable a
+// constraint, so start from DefaultUsernamePolicy to change one setting.
+type UsernamePolicy struct {
+    // MinLen and MaxLen bound the length in characters, not bytes.
+    MinLen int
+    MaxLen int
+    // Chars selects the letters and digits allowed.
+    Chars UsernameCharClass
+    // Special lists the characters allowed besides letters and digits,
+    // such as "_" or "_.-".
+    Special string
+    // MustStartWithLetter rejects names starting with a digit or a
+    // special character.
+    MustStartWithLetter bool
+    // NoConsecutiveSpecial rejects names with two special characters in
+    // a row, such as "a__b".
+    NoConsecutiveSpecial bool
+    // Reserved lists names that cannot be taken, compared ignoring case.
+    Reserved []string
+}
+
+// DefaultUsernamePolicy is the policy used by Username when none is given:
+// 3–20 ASCII letters, digits and underscores, starting with a letter,
+// without consecutive underscores, and not a common reserved name.
+var DefaultUsernamePolicy = UsernamePolicy{
+    MinLen:               3,
+    MaxLen:               20,
+    Chars:                UsernameCharsASCII,
+    Special:              "_",
+    MustStartWithLetter:  true,
+    NoConsecutiveSpecial: true,
+    Reserved:             []string{"admin", "administrator", "root", "system", "support", "moderator", "null"},
+}
+
+// Username validates that the value is a username allowed by `policy`,
+// or by DefaultUsernamePolicy when no policy is given. The error names
+// the constraint that was violated, checked in this order: length,
+// characters, first character, consecutive special characters, reserved
+// names. A policy whose MinLen is above its MaxLen is a *ConfigError (see
+// Validator.Err).
+//
+// Example:
+//    f.Username()
+//
+//    policy := validator.DefaultUsernamePolicy
+//    policy.Special = "_.-"
+//    f.Username(policy)
+func (f *Field) Username(policy ...UsernamePolicy) *Field {
+    p := DefaultUsernamePolicy
+    if len(policy) > 0 {
+        p = policy[0]
+    }
+
+    f.addRule("username", []interface{}{p}, func(f *Field) error {
+        str, ok := f.value.(string)
+        if !ok {
+            return mismatch(f, "a string")
+        }
+        return checkUsername(f.name, str, p)
+    })
+    var err error
+    if p.MaxLen > 0 {
+        err = boundsError(p.MinLen, p.MaxLen)
+    }
+    return f.misconfigured(err)
+}
+
+// checkUsername returns an error describing the first constraint of `p`
+// that `str` violates.
+func checkUsername(name, str string, p UsernamePolicy) error {
+    length := utf8.RuneCountInString(str)
+    if p.MinLen > 0 && length < p.MinLen {
+        return fmt.Errorf("%s must be at least %d characters", name, p.MinLen)
+    }
+    if p.MaxLen > 0 && length > p.MaxLen {
+        return fmt.Errorf("%s must be at most %d characters", name, p.MaxLen)
+    }
+
+    previousSpecial := false
+    for i, r := range str {
+        special := strings.ContainsRune(p.Special, r)
+        if !special && !usernameLetterOrDigit(r, p.Chars) {
+            return fmt.Errorf("%s can only contain %s", name, describeUsernameChars(p))
+        }
+        if i == 0 && p.MustStartWithLetter && (special || unicode.IsDigit(r)) {
+            return fmt.Errorf("%s must start with a letter", name)
+        }
+        if special && previousSpecial && p.NoConsecutiveSpecial {
+            return fmt.Errorf("%s cannot contain consecutive special characters", name)
+        }
+        previousSpecial = special
+    }
+
+    for _, reserved := range p.Reserved {
+        if strings.EqualFold(str, reserved) {
+            return fmt.Errorf("%s is reserved", name)
+        }
+    }
+    return nil
+}
+
+// usernameLetterOrDigit reports whether r is a letter or digit in `chars`.
+func usernameLetterOrDigit(r rune, chars UsernameCharClass) bool {
+    if chars == UsernameCharsUnicode {
+        return unicode.IsLetter(r) || unicode.IsDigit(r)
+    }
+    return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// describeUsernameChars lists the characters allowed by p for error messages,
+// such as `letters a-z, digits and "_"`.
+func describeUsernameChars(p UsernamePolicy) string {
+    parts := []string{"letters a-z", "digits"}
+    if p.Chars == UsernameCharsUnicode {
+        parts[0] = "letters"
+    }
+    for _, r := range p.Special {
+        parts = append(parts, strconv.Quote(string(r)))
+    }
+
+    last := len(parts) - 1
+    return strings.Join(parts[:last], ", ") + " and " + parts[last]
+}
@@ -17,15 +17,37 @@
 package validator
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/url"
+	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
-// Validator holds all the validation rules for multiple fields.
-// Call Validate() to check all rules.
+// Validator holds all the fields registered for validation, in
+// registration order. Call Validate() to check all rules.
 type Validator struct {
-    rules []func() error
+    fields        []*Field
+    parent        *Validator
+    prefix        string
+    ctx           context.Context
+    noRecover     bool
+    debug         bool
+    captureValues bool
+    strict        bool
+    dedup         bool
+    uniqueNames   bool
+    audit         bool
+    values        map[string]interface{}
+    last          *lastRun
+    onRule        func(field, rule string, err error, dur time.Duration)
+    onDone        func(errCount int, dur time.Duration)
 }
 
 // New creates and returns a new Validator instance.
@@ -35,7 +57,7 @@ type Validator struct {
 //    v := validator.New()
 //
 func New() *Validator {
-    return &Validator{rules: []func() error{}}
+    return &Validator{fields: []*Field{}}
 }
 
 // Field represents a single value being validated.
@@ -49,9 +71,101 @@ func New() *Validator {
 //        String().
 //        MinLength(3)etc.
 type Field struct {
-    validator *Validator
-    value     interface{}
-    name      string
+    validator  *Validator
+    raw        interface{}
+    value      interface{}
+    name       string
+    key        string
+    absent     bool
+    list       bool
+    optional   bool
+    omitAbsent bool
+    allowEmpty bool
+    warn       bool
+    each       bool
+    sensitive  bool
+    supplier   func() interface{}
+    rules      []*rule
+    desc       string
+}
+
+// rule is a single check registered on a field. The name and params
+// describe the check for tooling such as ToJSONSchema, while check
+// does the actual validation of the field's current value. A failing
+// warning rule is reported in Result.Warnings instead of the errors, and
+// an each rule checks every item of a list value.
+// The description, message and code are set by Explain, WithMessage
+// and WithCode.
+type rule struct {
+    name        string
+    params      []interface{}
+    check       func(f *Field) error
+    warning     bool
+    each        bool
+    description string
+    message     string
+    code        string
+    config      error
+}
+
+// fieldKey returns the key identifying the field in its input,
+// falling back to the display name for fields registered with Field().
+func (f *Field) fieldKey() string {
+    if f.key != "" {
+        return f.key
+    }
+    return f.name
+}
+
+// addRule appends a named check to the field. After Warn, the check
+// is a warning, and after Each it applies to every item.
+func (f *Field) addRule(name string, params []interface{}, check func(f *Field) error) {
+    f.rules = append(f.rules, &rule{name: name, params: params, check: check, warning: f.warn, each: f.each})
+    f.changed()
+}
+
+// updateLastRule applies `update` to a copy of the most recently added
+// rule, which replaces it in a fresh slice so fields sharing rules after
+// Merge, Clone or Schema binding are not affected. It does nothing when
+// the field has no rules.
+func (f *Field) updateLastRule(update func(r *rule)) {
+    n := len(f.rules)
+    if n == 0 {
+        return
+    }
+
+    last := *f.rules[n-1]
+    update(&last)
+    f.rules = append(f.rules[:n-1:n-1], &last)
+    f.changed()
+}
+
+// WithMessage replaces the error message of the most recently added rule,
+// taking precedence over a message passed to the rule itself. It reads
+// better than the trailing message argument and works with every rule.
+// Called before any rule is added to the field, it does nothing.
+//
+// Example:
+//    f.MinLength(8).WithMessage("Pick a longer password")
+func (f *Field) WithMessage(message string) *Field {
+    f.updateLastRule(func(r *rule) {
+        r.message = message
+    })
+    return f
+}
+
+// WithCode sets the machine readable code reported in ValidationError.Code
+// when the most recently added rule fails, for clients that map errors to
+// their own messages. Called before any rule is added to the field, it does
+// nothing.
+//
+// Example:
+//    f.Email().WithCode("email_invalid")
+func (f *Field) WithCode(code string) *Field {
+    f.updateLastRule(func(r *rule) {
+        r.code = code
+    })
+    return f
 }
 
 // Field registers a new field to validate.
@@ -62,31 +176,312 @@ type Field struct {
 //
 //    v.Field("john@example.com", "Email").Email()
 func (v *Validator) Field(value interface{}, name string) *Field {
-    return &Field{
-        validator: v,
+    owner := v.root()
+    f := &Field{
+        validator: owner,
+        raw:       value,
         value:     value,
-        name:      name,
+        name:      v.prefix + name,
+    }
+    owner.fields = append(owner.fields, f)
+    owner.Invalidate()
+    return f
+}
+
+// Scope registers the fields added by `build` with `name` as a prefix,
+// so reusable rule functions don't need to build names by hand.
+// Scopes can be nested; the prefixes are joined with dots.
+//
+// Example:
+//
+//    v.Scope("Billing Address", func(sv *validator.Validator) {
+//        addressRules(sv, order.Billing) // "Billing Address.City is required"
+//    })
+func (v *Validator) Scope(name string, build func(sv *Validator)) *Validator {
+    build(v.scoped(name))
+    return v
+}
+
+// Merge appends copies of every field registered on `other`, with their
+// rules, to v. Rules added to `other` afterwards do not affect v.
+//
+// Example:
+//
+//    v := validator.New()
+//    v.Merge(auth.CredentialRules(req))
+//    v.Merge(profile.ProfileRules(req))
+func (v *Validator) Merge(other *Validator) *Validator {
+    owner := v.root()
+    for _, f := range other.root().fields {
+        f.copyTo(owner)
+    }
+    return v
+}
+
+// Clone returns an independent copy of v. Fields and rules registered on
+// the clone never affect the original, so a shared base validator can be
+// extended per request.
+//
+// Example:
+//
+//    v := base.Clone()
+//    v.Field(req.Coupon, "Coupon").Optional().MaxLength(20)
+func (v *Validator) Clone() *Validator {
+    source := v.root()
+    clone := New()
+    clone.noRecover = source.noRecover
+    clone.debug = source.debug
+    clone.captureValues = source.captureValues
+    clone.strict = source.strict
+    clone.dedup = source.dedup
+    clone.uniqueNames = source.uniqueNames
+    clone.audit = source.audit
+    for key, value := range source.values {
+        clone.Set(key, value)
+    }
+    clone.onRule = source.onRule
+    clone.onDone = source.onDone
+    return clone.Merge(v)
+}
+
+// RecoverPanics controls whether a panic inside a rule, such as a Custom
+// rule with a bad type assertion, is converted into an error for that field.
+// Recovery is enabled by default so one broken rule cannot take down a
+// request; tests can disable it to surface bugs loudly.
+//
+// Example:
+//
+//    v := validator.New().RecoverPanics(false)
+func (v *Validator) RecoverPanics(enabled bool) *Validator {
+    v.root().noRecover = !enabled
+    return v.Invalidate()
+}
+
+// Debug enables debug mode, which adds details meant for developers,
+// such as the stack trace of a recovered panic (see PanicError) and the
+// type a rule received when it rejected a value for its type, as in "Age
+// must be an integer (received float64)" (see ValidationError.Received).
+// Keep it off in production so messages stay clean.
+//
+// Example:
+//
+//    v := validator.New().Debug(true)
+func (v *Validator) Debug(enabled bool) *Validator {
+    v.root().debug = enabled
+    return v.Invalidate()
+}
+
+// CaptureValues controls whether errors record the value that failed in
+// ValidationError.Value, for server-side logs of bad requests. It is off
+// by default so passwords and other secrets aren't logged by accident;
+// the rule and its parameter are always recorded.
+//
+// Example:
+//
+//    v := validator.New().CaptureValues(true)
+func (v *Validator) CaptureValues(enabled bool) *Validator {
+    v.root().captureValues = enabled
+    return v.Invalidate()
+}
+
+// StrictFields controls whether a field without any rules is an error,
+// such as "Email has no validation rules", to catch a v.Field call whose
+// rule chain was forgotten. It is off by default. The error has the rule
+// name "rules" and is reported whether or not the field is absent.
+//
+// Example:
+//
+//    v := validator.New().StrictFields(true)
+func (v *Validator) StrictFields(enabled bool) *Validator {
+    v.root().strict = enabled
+    return v.Invalidate()
+}
+
+// DedupErrors controls whether an error whose message is identical to an
+// earlier error of the same field is dropped, such as when a shared rule
+// function adds the same rule twice. Dropped errors don't count towards
+// the limit of ValidateN. Warnings are deduplicated the same way. It is
+// off by default.
+//
+// Example:
+//
+//    v := validator.New().DedupErrors(true)
+func (v *Validator) DedupErrors(enabled bool) *Validator {
+    v.root().dedup = enabled
+    return v.Invalidate()
+}
+
+// ForbidDuplicateFieldNames controls whether registering a field under a
+// key that is already used, such as "Email" added by two rule helpers, is
+// an error. The second registration is then reported as "Email is
+// registered more than once", with the rule name "duplicate", and its rules
+// don't run. By default duplicates are allowed, and ErrorsMap groups their
+// errors under the shared key.
+//
+// Example:
+//
+//    v := validator.New().ForbidDuplicateFieldNames(true)
+func (v *Validator) ForbidDuplicateFieldNames(enabled bool) *Validator {
+    v.root().uniqueNames = enabled
+    return v.Invalidate()
+}
+
+// OnRuleResult sets a function called after every rule is checked, with
+// the field key, the rule name, the rule's error (nil when it passed) and
+// how long the rule took, for metrics and tracing. Rules skipped because
+// their field is optional, absent or past the error limit are not
+// reported. Calls are made synchronously, in the order rules run, on the
+// goroutine running Validate. The hook can't change the outcome: a panic
+// inside it is ignored unless RecoverPanics(false) was set. nil removes it.
+//
+// Example:
+//
+//    v.OnRuleResult(func(field, rule string, err error, dur time.Duration) {
+//        if err != nil {
+//            ruleFailures.WithLabelValues(field, rule).Inc()
+//        }
+//    })
+func (v *Validator) OnRuleResult(hook func(field, rule string, err error, dur time.Duration)) *Validator {
+    v.root().onRule = hook
+    return v
+}
+
+// OnValidateDone sets a function called when a validation run finishes,
+// including runs that were aborted, with the number of errors found and
+// the duration of the run. Warnings are not counted. Like OnRuleResult,
+// it can't change the outcome. nil removes it.
+//
+// Example:
+//
+//    v.OnValidateDone(func(errCount int, dur time.Duration) {
+//        validationSeconds.Observe(dur.Seconds())
+//    })
+func (v *Validator) OnValidateDone(hook func(errCount int, dur time.Duration)) *Validator {
+    v.root().onDone = hook
+    return v
+}
+
+// copyTo registers a copy of f on v. The copy starts with the same rules
+// and options, but rules added to either one later are not shared.
+func (f *Field) copyTo(v *Validator) *Field {
+    c := *f
+    c.rules = f.rules[:len(f.rules):len(f.rules)]
+    c.validator = v
+    v.fields = append(v.fields, &c)
+    v.Invalidate()
+    return &c
+}
+
+// scoped returns a view of v that registers its fields on v
+// with `prefix` and a dot prepended to every field name.
+func (v *Validator) scoped(prefix string) *Validator {
+    return &Validator{parent: v, prefix: v.prefix + prefix + "."}
+}
+
+// root returns the validator that owns the fields registered
+// through v, following scoped views back to their origin.
+func (v *Validator) root() *Validator {
+    for v.parent != nil {
+        v = v.parent
     }
+    return v
+}
+
+// FieldMaybe registers a field that may be absent from the input, for
+// PATCH requests: `present` reports whether the field was sent. An absent
+// field skips all of its rules, including Required, while a present one is
+// checked as usual, so a field sent empty still fails Required unless
+// AllowEmpty is used. Result.Validated lists the fields that were present.
+//
+// Example:
+//
+//    v.FieldMaybe(patch.Email, patch.HasEmail, "Email").Required().Email()
+func (v *Validator) FieldMaybe(value interface{}, present bool, name string) *Field {
+    f := v.Field(value, name)
+    f.absent = !present
+    f.omitAbsent = true
+    return f
+}
+
+// FieldFunc registers a field whose value is computed lazily.
+// `supplier` is called once per Validate run, before any rule is checked,
+// and its result is used by every rule on the field. This lets rules on
+// other fields see values that only exist at validation time.
+// A panic inside the supplier is reported as an error for this field
+// and its rules are skipped.
+//
+// Example:
+//
+//    v.FieldFunc(func() interface{} { return order.Total() }, "Total").
+//        Number().
+//        Min(1)
+func (v *Validator) FieldFunc(supplier func() interface{}, name string) *Field {
+    f := v.Field(nil, name)
+    f.supplier = supplier
+    return f
+}
+
+// resolve resets the working value of a field before a Validate run.
+// Rules that convert the value (such as IntegerString) then start from the
+// registered value again, and lazily evaluated fields call their supplier,
+// converting a panic into an error. Values from database/sql and JSON
+// null are unwrapped (see unwrapValue).
+func (f *Field) resolve() (err error) {
+    if f.supplier == nil {
+        f.value = unwrapValue(f.raw)
+        return nil
+    }
+
+    defer func() {
+        if r := recover(); r != nil {
+            f.value = nil
+            err = fmt.Errorf("%s could not be evaluated: %v", f.name, r)
+        }
+    }()
+
+    f.value = unwrapValue(f.supplier())
+    return nil
 }
 
-// String ensures the field value is a string.
+// Value returns the working value of the field as left by the last
+// Validate run, after rules that rewrite it (such as IntegerString and
+// Normalize) have been applied. Before the first run it is the
+// registered value.
+//
+// Example:
+//
+//    name := v.Field(input, "Name").Normalize("NFC").MinLength(2)
+//    if errs := v.Validate(false); len(errs) == 0 {
+//        save(name.Value().(string))
+//    }
+func (f *Field) Value() interface{} {
+    return f.value
+}
+
+// String ensures the field value is a string. A []string, such as every
+// value of a repeated form key, passes too, since each of its items is one.
+// A []byte, such as a database column scanned as bytes, fails; convert it
+// with AsString first, as for every string rule.
 // Optionally accepts a custom error message.
 //
 // Example:
 //    f.String()
 //    f.String("Username must be text")
 func (f *Field) String(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("string", nil, func(f *Field) error {
         message := ""
     if len(messages) > 0 {
         message = messages[0]
     }
         _, ok := f.value.(string)
+        if _, isList := f.value.([]string); isList {
+            ok = true
+        }
         if !ok {
             if message != "" {
                 return fmt.Errorf("%s", message);
             }
-            return fmt.Errorf("%s must be a string", f.name);
+            return mismatch(f, "a string");
         }
         return nil;
     })
@@ -94,31 +489,149 @@ func (f *Field) String(messages ...string) *Field {
 }
 
 // Required ensures the field value is not empty.
-// Works for string, int, float64, bool, and nil.
+// Works for strings, numbers (zero is empty), bool, slices, maps, and nil.
+// Fields read from input where the key is missing also fail.
 //
 // Example:
 //    f.Required()
 func (f *Field) Required() *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
-        switch v := f.value.(type) {
-        case string:
-            if len(v) == 0 {
-                return fmt.Errorf("%s is required", f.name)
-            }
-        case int:
-            if v == 0 {
-                return fmt.Errorf("%s is required", f.name)
-            }
-        case float64:
-            if v == 0.0 {
-                return fmt.Errorf("%s is required", f.name)
-            }
-        case bool:
-            // usually boolean always has a value, skip if not needed
-        default:
-            if f.value == nil {
-                return fmt.Errorf("%s is required", f.name)
+    f.addRule("required", nil, checkRequired)
+    return f
+}
+
+// RequiredNonBlank is like Required, but a string made only of white
+// space, such as "   ", "\t" or a non-breaking space, is also empty.
+// Interior spaces don't count as blank, and the value isn't changed; use
+// Trim to also remove the surrounding white space.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.RequiredNonBlank()
+//    f.Trim().RequiredNonBlank("Enter your name")
+func (f *Field) RequiredNonBlank(messages ...string) *Field {
+    f.addRule("requiredNonBlank", nil, func(f *Field) error {
+        err := checkRequired(f)
+        if str, ok := f.value.(string); ok && strings.TrimSpace(str) == "" {
+            err = fmt.Errorf("%s is required", f.name)
+        }
+
+        if err != nil && len(messages) > 0 {
+            return fmt.Errorf("%s", messages[0])
+        }
+        return err
+    })
+    return f
+}
+
+// Trim removes leading and trailing white space, as defined by Unicode,
+// from a string value, so the rules after it and Value see the trimmed
+// string. Other values are left unchanged.
+//
+// Example:
+//    f.Trim().MinLength(2)
+func (f *Field) Trim() *Field {
+    f.addRule("trim", nil, func(f *Field) error {
+        if str, ok := f.value.(string); ok {
+            f.value = strings.TrimSpace(str)
+        }
+        return nil
+    })
+    return f
+}
+
+// checkRequired is the check of Required.
+func checkRequired(f *Field) error {
+    switch v := f.value.(type) {
+    case string:
+        if len(v) == 0 {
+            return fmt.Errorf("%s is required", f.name)
+        }
+    case int:
+        if v == 0 {
+            return fmt.Errorf("%s is required", f.name)
+        }
+    case float64:
+        if v == 0.0 {
+            return fmt.Errorf("%s is required", f.name)
+        }
+    case bool:
+        // usually boolean always has a value, skip if not needed
+    default:
+        if f.value == nil {
+            return fmt.Errorf("%s is required", f.name)
+        }
+        if n, ok := toFloat64(f.value); ok && n == 0 {
+            return fmt.Errorf("%s is required", f.name)
+        }
+        rv := reflect.ValueOf(f.value)
+        if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.Len() == 0 {
+            return fmt.Errorf("%s is required", f.name)
+        }
+    }
+    return nil
+}
+
+// Optional marks the field as optional: when its value is missing or
+// empty (nil, "", or an empty slice or map), every other rule on the
+// field is skipped. Zero numbers are not considered empty.
+//
+// Example:
+//    f.Optional().Email()
+func (f *Field) Optional() *Field {
+    f.optional = true
+    f.changed()
+    return f
+}
+
+// AllowEmpty skips every other rule on the field, including Required, when
+// its value is empty (nil, "", or an empty slice or map). Unlike Optional it
+// does not apply to absent fields, so for PATCH requests a field sent empty
+// to clear it passes, while absence is handled by IfPresent or FieldMaybe.
+//
+// Example:
+//    v.FieldMaybe(patch.Nickname, patch.HasNickname, "Nickname").
+//        AllowEmpty().
+//        MinLength(3)
+func (f *Field) AllowEmpty() *Field {
+    f.allowEmpty = true
+    f.changed()
+    return f
+}
+
+// IfPresent skips every rule on the field, including Required, when the
+// field is absent from its input, such as a key missing from the map passed
+// to Schema.ValidateMap. Present fields are checked as usual, so a field
+// sent empty still fails Required. This is what PATCH endpoints need:
+// absent fields are left unchanged rather than reported.
+//
+// Example:
+//    s.Field("email", "Email").IfPresent().Required().Email()
+func (f *Field) IfPresent() *Field {
+    f.omitAbsent = true
+    f.changed()
+    return f
+}
+
+// Present ensures the field was included in the input, even if its value
+// is empty. This differs from Required, which also rejects empty values.
+// Fields registered directly with Field() are always present.
+// Accepts an optional custom error message.
+//
+// Example:
+//    s.Field("nickname").Present()
+//    s.Field("nickname").Present("Nickname must be sent, even if blank")
+func (f *Field) Present(messages ...string) *Field {
+    f.addRule("present", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if f.absent {
+            if message != "" {
+                return fmt.Errorf("%s", message)
             }
+            return fmt.Errorf("%s must be present", f.name)
         }
         return nil
     })
@@ -126,14 +639,18 @@ func (f *Field) Required() *Field {
 }
 
 
+// emailPattern matches the email addresses accepted by Email.
+var emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
 // Email validates that the field value is a valid email address.
+// Use EmailWith(EmailAllowIDN) to accept internationalized domains.
 // Accepts an optional custom error message.
 //
 // Example:
 //    f.Email()
 //    f.Email("Invalid email format")
 func (f *Field) Email(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("email", nil, func(f *Field) error {
  	message := ""
     if len(messages) > 0 {
         message = messages[0]
@@ -146,8 +663,7 @@ func (f *Field) Email(messages ...string) *Field {
             return fmt.Errorf("%s must be a valid email", f.name)
         }
 
-        re := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-        if !re.MatchString(str) {
+        if !emailPattern.MatchString(str) {
 			if message != "" {
                 return fmt.Errorf("%s", message);
             }
@@ -159,67 +675,67 @@ func (f *Field) Email(messages ...string) *Field {
 }
 
 
-// Min checks that an integer value is greater than or equal to `length`.
+// Min checks that a numeric value is greater than or equal to `length`.
+// Any value accepted by Number() can be checked.
 // Accepts an optional custom error message.
 //
 // Example:
 //    f.Min(10)
 //    f.Min(10, "Value must be at least 10")
 func (f *Field) Min(length int, messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("min", []interface{}{length}, func(f *Field) error {
         message := ""
-    if len(messages) > 0 {
-        message = messages[0]
-    }
-		
-      value, ok := f.value.(int);
+        if len(messages) > 0 {
+            message = messages[0]
+        }
 
-	  if (!ok) {
-			if message != "" {
-                return fmt.Errorf("%s", message);
+        value, ok := toFloat64(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
             }
-		return fmt.Errorf("%s must be an integer ", f.name)
-	  }
+            return mismatch(f, "a number")
+        }
 
-	  if (value < length) {
-		if message != "" {
-            return fmt.Errorf("%s", message);
+        if value < float64(length) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%v cannot be less than %d", f.value, length)
         }
-		return fmt.Errorf("%d cannot be less than %d", value, length)
-	  }
         return nil
     })
     return f
 }
 
-// Max checks that an integer value does not exceed `length`.
+// Max checks that a numeric value does not exceed `length`.
+// Any value accepted by Number() can be checked.
 // Accepts an optional custom error message.
 //
 // Example:
 //    f.Max(100)
 //    f.Max(100, "Too large")
 func (f *Field) Max(length int, messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("max", []interface{}{length}, func(f *Field) error {
         message := ""
-    if len(messages) > 0 {
-        message = messages[0]
-    }
-		
-      value, ok := f.value.(int);
+        if len(messages) > 0 {
+            message = messages[0]
+        }
 
-	  if (!ok) {
-		if message != "" {
-            return fmt.Errorf("%s", message);
+        value, ok := toFloat64(f.value)
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a number")
         }
-		return fmt.Errorf("%s must be an integer ", f.name)
-	  }
 
-	  if (value > length) {
-		if message != "" {
-            return fmt.Errorf("%s", message);
+        if value > float64(length) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%v cannot be greater than %d", f.value, length)
         }
-		return fmt.Errorf("%d cannot be greater than %d", value, length)
-	  }
         return nil
     })
     return f
@@ -227,13 +743,15 @@ func (f *Field) Max(length int, messages ...string) *Field {
 
 
 // MinLength validates that a string's length is at least `length`.
+// The length is counted in bytes of UTF-8, so "café" has 5; a []byte
+// converted with AsString keeps its length.
 // Accepts an optional custom error message.
 //
 // Example:
 //    f.MinLength(3)
 //    f.MinLength(3, "Too short")
 func (f *Field) MinLength(length int, messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("minLength", []interface{}{length}, func(f *Field) error {
 
     message := ""
     if len(messages) > 0 {
@@ -246,7 +764,7 @@ func (f *Field) MinLength(length int, messages ...string) *Field {
 		if message != "" {
         	return fmt.Errorf("%s", message);
         }
-		return fmt.Errorf("%s must be a string ", f.name)
+		return mismatch(f, "a string")
 	  }
 
 	  if (len(value) < length) {
@@ -261,13 +779,14 @@ func (f *Field) MinLength(length int, messages ...string) *Field {
 }
 
 // MaxLength validates that a string's length is not greater than `length`.
+// The length is counted in bytes of UTF-8, as for MinLength.
 // Accepts an optional custom error message.
 //
 // Example:
 //    f.MaxLength(20)
 //    f.MaxLength(20, "Too long")
 func (f *Field) MaxLength(length int, messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("maxLength", []interface{}{length}, func(f *Field) error {
 		message := ""
     if len(messages) > 0 {
         message = messages[0]
@@ -278,7 +797,7 @@ func (f *Field) MaxLength(length int, messages ...string) *Field {
 		if message != "" {
             return fmt.Errorf("%s", message);
         }
-		return fmt.Errorf("%s must be a string ", f.name)
+		return mismatch(f, "a string")
 	  }
 
 	  if (len(value) > length) {
@@ -292,22 +811,99 @@ func (f *Field) MaxLength(length int, messages ...string) *Field {
     return f
 }
 
+// Number ensures the field value is numeric.
+// All integer and float kinds are accepted, as well as json.Number,
+// so values decoded from JSON (float64) pass as expected.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Number()
+//    f.Number("Age must be a number")
 func (f *Field) Number(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("number", nil, func(f *Field) error {
         message := ""
-    if len(messages) > 0 {
-        message = messages[0]
-    }
-        _, ok := f.value.(int)
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if _, ok := toFloat64(f.value); !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a number")
+        }
+        return nil
+    })
+    return f
+}
+
+// Integer ensures the field value is a whole number.
+// All integer kinds pass. Floats (and json.Number) pass only when they
+// have no fractional part, so 3.0 decoded from JSON is accepted while 3.5 is not.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Integer()
+//    f.Integer("Quantity must be a whole number")
+func (f *Field) Integer(messages ...string) *Field {
+    f.addRule("integer", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if !isInteger(f.value) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "an integer")
+        }
+        return nil
+    })
+    return f
+}
+
+
+// IntegerString validates that the field value is a string holding a
+// whole number, such as a query parameter, and converts the value to an int
+// so that Min() and Max() can be chained after it. Numbers that don't fit
+// in an int are reported as too large or too small, never truncated; use
+// Int64String for IDs that may not fit on 32-bit builds.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.IntegerString().Min(1)
+//    f.IntegerString("Page must be a number")
+func (f *Field) IntegerString(messages ...string) *Field {
+    f.addRule("integerString", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
         if !ok {
             if message != "" {
-                return fmt.Errorf("%s", message);
+                return fmt.Errorf("%s", message)
             }
-            return fmt.Errorf("%s must be a number", f.name);
+            return mismatch(f, "an integer")
         }
-        return nil;
+
+        n, err := strconv.Atoi(str)
+        if err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            if errors.Is(err, strconv.ErrRange) {
+                return parseIntError(f, str, err)
+            }
+            return fmt.Errorf("%s must be an integer", f.name)
+        }
+
+        f.value = n
+        return nil
     })
-    return f;
+    return f
 }
 
 
@@ -319,7 +915,7 @@ func (f *Field) Number(messages ...string) *Field {
 //    f.Phone()
 //    f.Phone("Invalid phone format")
 func (f *Field) Phone(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("phone", nil, func(f *Field) error {
         message := ""
     if len(messages) > 0 {
         message = messages[0]
@@ -345,24 +941,81 @@ func (f *Field) Phone(messages ...string) *Field {
 }
 
 
-// Validate runs all validation rules.
-// If stopOnFirst is true, it stops at the first error.
+// Validate runs all validation rules, field by field in registration order.
+// Lazily evaluated fields are resolved first so every rule sees their values.
+// If stopOnFirst is true, it stops at the first error. Errors are ordered
+// by field, then by rule (see Result.Errors), so stopOnFirst returns the
+// first error of a full run.
+//
+// Rules that need a context, such as UniqueBy, run with context.Background().
+// If one of their lookups fails, validation stops and the *LookupError is
+// returned as the last error; use ValidateContext to get it separately.
+// Likewise, a validator with misconfigured rules returns their
+// *ConfigErrors as its only error (see Err).
+//
+// Validating again without changing the fields, rules or options returns
+// the result of the previous run without checking the rules again, unless
+// v has lazily evaluated fields or lookup rules. See LastResult and
+// Invalidate.
 func (v *Validator) Validate(stopOnFirst bool) []error {
-	var allErrors []error
-
-	for _, rule := range v.rules {
-		if err := rule(); err != nil {
-			if stopOnFirst {
-				return []error{err}
-			}
-			allErrors = append(allErrors, err)
-		}
+	limit := 0
+	if stopOnFirst {
+		limit = 1
 	}
 
-	if len(allErrors) == 0 {
-		return nil 
+	res := v.runCached(context.Background(), limit)
+	if res.err != nil {
+		return append(res.errors, res.err)
 	}
-	return allErrors
+	return res.errors
+}
+
+// ValidateContext runs all validation rules like Validate, passing ctx to
+// rules that perform lookups, such as UniqueBy and ExistsBy.
+//
+// Problems with the input are returned as the []error. The error result is
+// reserved for failures that are not the user's fault: a lookup callback
+// returning an error (wrapped in a *LookupError), ctx being done, or
+// misconfigured rules (*ConfigErrors, see Err).
+// Validation stops at such a failure and the []error is then nil.
+//
+// Example:
+//
+//    errs, err := v.ValidateContext(r.Context(), false)
+//    if err != nil {
+//        http.Error(w, "internal error", http.StatusInternalServerError)
+//        return
+//    }
+//    if errs != nil {
+//        // respond with 422
+//    }
+func (v *Validator) ValidateContext(ctx context.Context, stopOnFirst bool) ([]error, error) {
+	limit := 0
+	if stopOnFirst {
+		limit = 1
+	}
+
+	res := v.runCached(ctx, limit)
+	if res.err != nil {
+		return nil, res.err
+	}
+	return res.errors, nil
+}
+
+// ValidateN runs all validation rules like Validate, but stops once
+// `max` errors have been collected. No further rules are checked after
+// that, and Result.Truncated reports whether more errors were found.
+// A max of 0 or less collects every error. ValidateN(1) is equivalent
+// to Validate(true).
+//
+// Example:
+//
+//    res := v.ValidateN(100)
+//    if res.Truncated() {
+//        fmt.Println("showing the first 100 errors")
+//    }
+func (v *Validator) ValidateN(max int) *Result {
+	return v.runCached(context.Background(), max)
 }
 
 
@@ -375,7 +1028,7 @@ func (v *Validator) Validate(stopOnFirst bool) []error {
 //    f.Url()
 //    f.Url("Invalid URL format")
 func (f *Field) Url(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("url", nil, func(f *Field) error {
         message := ""
         if len(messages) > 0 {
             message = messages[0]
@@ -412,7 +1065,7 @@ func (f *Field) Url(messages ...string) *Field {
 //    f.UUID()
 //    f.UUID("Invalid UUID format")
 func (f *Field) UUID(messages ...string) *Field {
-    f.validator.rules = append(f.validator.rules, func() error {
+    f.addRule("uuid", nil, func(f *Field) error {
         message := ""
         if len(messages) > 0 {
             message = messages[0]
@@ -440,3 +1093,289 @@ func (f *Field) UUID(messages ...string) *Field {
 
     return f
 }
+
+
+// Bool ensures the field value is a boolean.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Bool()
+//    f.Bool("Subscribe must be true or false")
+func (f *Field) Bool(messages ...string) *Field {
+    f.addRule("bool", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if _, ok := f.value.(bool); !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return mismatch(f, "a boolean")
+        }
+        return nil
+    })
+    return f
+}
+
+
+// Matches validates that the string value matches the regular expression `pattern`.
+// The pattern is compiled once, when the rule is registered; an invalid
+// pattern is a *ConfigError (see Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Matches(`^[A-Z]{3}-\d{4}$`)
+//    f.Matches(`^[A-Z]{3}-\d{4}$`, "Reference must look like ABC-1234")
+func (f *Field) Matches(pattern string, messages ...string) *Field {
+    re, compileErr := regexp.Compile(pattern)
+
+    f.addRule("matches", []interface{}{pattern}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if !ok || !re.MatchString(str) {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s has an invalid format", f.name)
+        }
+        return nil
+    })
+    return f.misconfigured(compileErr)
+}
+
+
+// OneOf validates that the field value equals one of `values`.
+// Numbers are compared by value, so 1 matches 1.0 decoded from JSON.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.OneOf([]interface{}{"draft", "published"})
+//    f.OneOf([]interface{}{1, 2, 3}, "Pick a valid plan")
+func (f *Field) OneOf(values []interface{}, messages ...string) *Field {
+    f.addRule("oneOf", values, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        for _, allowed := range values {
+            if equalValues(f.value, allowed) {
+                return nil
+            }
+        }
+
+        if message != "" {
+            return fmt.Errorf("%s", message)
+        }
+        return fmt.Errorf("%s must be one of %s", f.name, joinValues(values))
+    })
+    return f
+}
+
+
+// Date validates that the string value is a calendar date in the
+// YYYY-MM-DD format (RFC 3339 full-date), such as "2024-02-29".
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.Date()
+//    f.Date("Birthday must look like 1990-12-31")
+func (f *Field) Date(messages ...string) *Field {
+    f.addRule("date", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok {
+            _, err := time.Parse("2006-01-02", str)
+            ok = err == nil
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return fmt.Errorf("%s must be a valid date (YYYY-MM-DD)", f.name)
+        }
+        return nil
+    })
+    return f
+}
+
+
+// Custom validates the field value with a user supplied function.
+// The function returns nil when the value is valid, or an error that is
+// reported as is. Custom rules are not exported by ToJSONSchema; use
+// Description() to document them.
+// Accepts an optional custom error message, used instead of the returned error.
+//
+// Example:
+//    f.Custom(func(value interface{}) error {
+//        if value == "admin" {
+//            return errors.New("Username is reserved")
+//        }
+//        return nil
+//    })
+func (f *Field) Custom(fn func(value interface{}) error, messages ...string) *Field {
+    f.addRule("custom", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        if err := fn(f.value); err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return err
+        }
+        return nil
+    })
+    return f
+}
+
+
+// Warn marks the rules added after it on this field as warnings: they are
+// checked like any other rule, but their failures are reported by
+// Result.Warnings and never make validation fail. Validate, which only
+// returns errors, ignores them, and they don't count towards the limits of
+// Validate(true) and ValidateN. Rules added before Warn stay errors.
+//
+// Example:
+//    v.Field(password, "Password").
+//        Required().
+//        MinLength(8).
+//        Warn().
+//        MinLength(12, "Password is weak, consider a longer one")
+func (f *Field) Warn() *Field {
+    f.warn = true
+    return f
+}
+
+// CustomWarning is Custom reported as a warning, whether or not Warn was
+// called.
+// Accepts an optional custom error message, used instead of the returned error.
+//
+// Example:
+//    f.CustomWarning(func(value interface{}) error {
+//        if s, _ := value.(string); !strings.HasPrefix(s, "+") {
+//            return errors.New("Phone number has no country code")
+//        }
+//        return nil
+//    })
+func (f *Field) CustomWarning(fn func(value interface{}) error, messages ...string) *Field {
+    warn := f.warn
+    f.warn = true
+    f.Custom(fn, messages...)
+    f.warn = warn
+    return f
+}
+
+
+// Description attaches a human readable description to the field.
+// It is emitted by ToJSONSchema and is the place to document
+// checks, such as Custom rules, that have no schema equivalent.
+//
+// Example:
+//    f.Custom(checkUsername).Description("Must not be a reserved name")
+func (f *Field) Description(text string) *Field {
+    f.desc = text
+    return f
+}
+
+// toFloat64 converts any integer or float kind, or a json.Number,
+// to a float64. It reports false for non-numeric values and NaN.
+func toFloat64(value interface{}) (float64, bool) {
+    if n, ok := value.(json.Number); ok {
+        fv, err := n.Float64()
+        if err != nil || math.IsNaN(fv) {
+            return 0, false
+        }
+        return fv, true
+    }
+
+    rv := reflect.ValueOf(value)
+    switch rv.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return float64(rv.Int()), true
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        return float64(rv.Uint()), true
+    case reflect.Float32, reflect.Float64:
+        fv := rv.Float()
+        if math.IsNaN(fv) {
+            return 0, false
+        }
+        return fv, true
+    }
+    return 0, false
+}
+
+// equalValues compares two field values, comparing numbers by value
+// so that an int and a float64 holding the same number are equal.
+func equalValues(a, b interface{}) bool {
+    if af, ok := toFloat64(a); ok {
+        bf, ok := toFloat64(b)
+        return ok && af == bf
+    }
+    return reflect.DeepEqual(a, b)
+}
+
+// joinValues formats a list of allowed values for an error message.
+func joinValues(values []interface{}) string {
+    parts := make([]string, len(values))
+    for i, value := range values {
+        parts[i] = fmt.Sprint(value)
+    }
+    return strings.Join(parts, ", ")
+}
+
+// isEmpty reports whether value is nil, an empty string,
+// or an empty slice or map.
+func isEmpty(value interface{}) bool {
+    if value == nil {
+        return true
+    }
+    if str, ok := value.(string); ok {
+        return str == ""
+    }
+
+    rv := reflect.ValueOf(value)
+    switch rv.Kind() {
+    case reflect.Slice, reflect.Map:
+        return rv.Len() == 0
+    }
+    return false
+}
+
+// isFloat reports whether value is a float kind.
+func isFloat(value interface{}) bool {
+    switch reflect.ValueOf(value).Kind() {
+    case reflect.Float32, reflect.Float64:
+        return true
+    }
+    return false
+}
+
+// isInteger reports whether value is an integer kind, or a float
+// (or json.Number) holding a finite whole number.
+func isInteger(value interface{}) bool {
+    switch reflect.ValueOf(value).Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+        reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        return true
+    }
+
+    fv, ok := toFloat64(value)
+    if !ok || math.IsInf(fv, 0) {
+        return false
+    }
+    return fv == math.Trunc(fv)
+}
@@ -0,0 +1,339 @@
+package validator
+
+import (
+    "errors"
+    "strings"
+    "testing"
+)
+
+// firstError validates v and returns its first error message, or "".
+func firstError(v *Validator) string {
+    errs := v.Validate(false)
+    if len(errs) == 0 {
+        return ""
+    }
+    return errs[0].Error()
+}
+
+// passes reports whether value passes the rules `rules` adds to a field.
+func passes(value interface{}, rules func(f *Field)) bool {
+    v := New()
+    rules(v.Field(value, "Value"))
+    return v.Validate(false) == nil
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+    base := New()
+    email := base.Field("ada@example.com", "Email").Required().Email()
+    base.Set("plan", "free")
+
+    clone := base.Clone()
+    clone.Field("", "Coupon").Required()
+    clone.fields[0].MaxRunes(3)
+    clone.Set("plan", "pro")
+    clone.StrictFields(true)
+    clone.Field(nil, "Notes")
+
+    if errs := base.Validate(false); errs != nil {
+        t.Errorf("base reports the errors of the clone: %v", errs)
+    }
+    if len(base.fields) != 1 || len(base.fields[0].rules) != 2 {
+        t.Errorf("base has %d fields, the first with %d rules; want 1 with 2", len(base.fields), len(base.fields[0].rules))
+    }
+    if plan := base.Get("plan"); plan != "free" {
+        t.Errorf("base plan = %v, want free", plan)
+    }
+    if base.strict {
+        t.Error("StrictFields on the clone enabled it on the base")
+    }
+
+    // Rules added to the base later don't reach the clone either.
+    email.MinLength(100)
+    want := []string{"Email cannot be longer than 3 characters", "Coupon is required", "Notes has no validation rules"}
+    var got []string
+    for _, err := range clone.Validate(false) {
+        got = append(got, err.Error())
+    }
+    if len(got) != len(want) {
+        t.Fatalf("clone errors %q, want %q", got, want)
+    }
+    for i := range want {
+        if got[i] != want[i] {
+            t.Errorf("clone error %d = %q, want %q", i, got[i], want[i])
+        }
+    }
+}
+
+func TestRequiredNonBlank(t *testing.T) {
+    tests := []struct {
+        value   interface{}
+        message string
+    }{
+        {"Ada", ""},
+        {"Ada Lovelace", ""},
+        {" Ada ", ""},
+        {"", "Name is required"},
+        {"   ", "Name is required"},
+        {"\t", "Name is required"},
+        {"\u00a0", "Name is required"},
+        {"\u00a0\u00a0 \t", "Name is required"},
+        {"\u00a0Ada\u00a0", ""},
+        {"Ada\u00a0\u00a0Lovelace", ""},
+        {" \t\n  ", "Name is required"},
+        {nil, "Name is required"},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "Name").RequiredNonBlank()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("RequiredNonBlank(%q): got %q, want %q", tt.value, got, tt.message)
+        }
+
+        // Trimming first doesn't change the outcome.
+        v = New()
+        v.Field(tt.value, "Name").Trim().RequiredNonBlank()
+        if got := firstError(v); got != tt.message {
+            t.Errorf("Trim().RequiredNonBlank(%q): got %q, want %q", tt.value, got, tt.message)
+        }
+    }
+
+    v := New()
+    v.Field("   ", "Name").RequiredNonBlank("Enter your name")
+    if got, want := firstError(v), "Enter your name"; got != want {
+        t.Errorf("got %q, want %q", got, want)
+    }
+}
+
+func TestTrimIsIdempotent(t *testing.T) {
+    for _, value := range []string{" Ada ", "\tAda Lovelace ", "\u00a0Ada\u00a0", "Ada", "   "} {
+        v := New()
+        once := v.Field(value, "Name").Trim()
+        twice := v.Field(value, "Name").Trim().Trim()
+        kept := v.Field(value, "Name").RequiredNonBlank()
+        v.Validate(false)
+
+        if once.Value() != twice.Value() {
+            t.Errorf("Trim(%q) = %q, but twice gives %q", value, once.Value(), twice.Value())
+        }
+        if kept.Value() != value {
+            t.Errorf("RequiredNonBlank changed %q to %q", value, kept.Value())
+        }
+    }
+}
+
+func TestDuplicateFieldNames(t *testing.T) {
+    register := func(v *Validator) {
+        v.Field("", "Email").Required()
+        v.Field("not an email", "Email").Email()
+    }
+
+    // By default both fields run and share their key.
+    v := New()
+    register(v)
+    res := v.ValidateN(0)
+    want := []string{"Email is required", "Email must be a valid email"}
+    if got := res.ErrorsMap()["Email"]; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+        t.Errorf("ErrorsMap()[Email] = %q, want %q", got, want)
+    }
+
+    // When forbidden, the second registration is the error.
+    v = New().ForbidDuplicateFieldNames(true)
+    register(v)
+    errs := v.Validate(false)
+    if len(errs) != 2 {
+        t.Fatalf("got %d errors, want 2: %v", len(errs), errs)
+    }
+    if got, want := errs[0].Error(), "Email is required"; got != want {
+        t.Errorf("first error %q, want %q", got, want)
+    }
+    var verr ValidationError
+    if !errors.As(errs[1], &verr) || verr.Message != "Email is registered more than once" || verr.Rule != "duplicate" {
+        t.Errorf("second error = %#v, want the duplicate registration", errs[1])
+    }
+}
+
+func TestDedupErrors(t *testing.T) {
+    // passwordRules is a shared rule function that ends up applied twice.
+    passwordRules := func(f *Field) {
+        f.MinRunes(8)
+    }
+    build := func(dedup bool) *Validator {
+        v := New().DedupErrors(dedup)
+        password := v.Field("short", "Password")
+        passwordRules(password)
+        passwordRules(password)
+        v.Field("", "Email").Required().Custom(func(value interface{}) error {
+            return errors.New("Password must be at least 8 characters long")
+        })
+        return v
+    }
+
+    tests := []struct {
+        dedup bool
+        limit int
+        want  []string
+    }{
+        {false, 0, []string{
+            "Password must be at least 8 characters long",
+            "Password must be at least 8 characters long",
+            "Email is required",
+            "Password must be at least 8 characters long",
+        }},
+        // The same message on another field is kept.
+        {true, 0, []string{
+            "Password must be at least 8 characters long",
+            "Email is required",
+            "Password must be at least 8 characters long",
+        }},
+        // Dropped errors don't count towards the limit.
+        {true, 2, []string{
+            "Password must be at least 8 characters long",
+            "Email is required",
+        }},
+        {false, 2, []string{
+            "Password must be at least 8 characters long",
+            "Password must be at least 8 characters long",
+        }},
+    }
+
+    for _, tt := range tests {
+        var got []string
+        for _, err := range build(tt.dedup).ValidateN(tt.limit).Errors() {
+            got = append(got, err.Error())
+        }
+        if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+            t.Errorf("dedup %v, limit %d: got %q, want %q", tt.dedup, tt.limit, got, tt.want)
+        }
+    }
+}
+
+func TestErrorOrder(t *testing.T) {
+    build := func() *Validator {
+        v := New()
+        v.Field("x", "Name").MinRunes(3).Matches(`^[0-9]+$`)
+        v.Field("", "Email").Required()
+        v.Field(15, "Age").Min(18)
+        v.Field("bad", "Website").Url()
+        return v
+    }
+
+    var full []string
+    for _, err := range build().Validate(false) {
+        verr := err.(ValidationError)
+        full = append(full, verr.Field+"/"+verr.Rule)
+    }
+    want := []string{"Name/minRunes", "Name/matches", "Email/required", "Age/min", "Website/url"}
+    if strings.Join(full, " ") != strings.Join(want, " ") {
+        t.Fatalf("errors ordered %q, want %q", full, want)
+    }
+
+    // stopOnFirst and every limit return a prefix of the full run.
+    if errs := build().Validate(true); len(errs) != 1 || errs[0].(ValidationError).Rule != "minRunes" {
+        t.Errorf("Validate(true) = %v, want the first error of the full run", errs)
+    }
+    for n := 1; n <= len(want); n++ {
+        errs := build().ValidateN(n).Errors()
+        if len(errs) != n {
+            t.Fatalf("ValidateN(%d) returned %d errors", n, len(errs))
+        }
+        for i, err := range errs {
+            verr := err.(ValidationError)
+            if got := verr.Field + "/" + verr.Rule; got != want[i] {
+                t.Errorf("ValidateN(%d) error %d = %s, want %s", n, i, got, want[i])
+            }
+        }
+    }
+}
+
+func TestStrictFields(t *testing.T) {
+    tests := []struct {
+        strict bool
+        build  func(v *Validator)
+        want   []string
+    }{
+        {true, func(v *Validator) { v.Field("ada@example.com", "Email") }, []string{"Email has no validation rules"}},
+        {true, func(v *Validator) { v.Field(nil, "Email") }, []string{"Email has no validation rules"}},
+        {true, func(v *Validator) { v.FieldMaybe(nil, false, "Email") }, []string{"Email has no validation rules"}},
+        {true, func(v *Validator) { v.Field("ada@example.com", "Email").Email() }, nil},
+        {true, func(v *Validator) { v.Field("ada", "Email").Email() }, []string{"Email must be a valid email"}},
+        {false, func(v *Validator) { v.Field("ada@example.com", "Email") }, nil},
+        {true, func(v *Validator) {
+            v.Field("ada@example.com", "Email").Email()
+            v.Field("Ada", "Name")
+        }, []string{"Name has no validation rules"}},
+    }
+
+    for i, tt := range tests {
+        v := New().StrictFields(tt.strict)
+        tt.build(v)
+        var got []string
+        for _, err := range v.Validate(false) {
+            got = append(got, err.Error())
+        }
+        if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+            t.Errorf("case %d: got %q, want %q", i, got, tt.want)
+        }
+    }
+
+    v := New().StrictFields(true)
+    v.Field("ada@example.com", "Email")
+    if verr := v.Validate(false)[0].(ValidationError); verr.Rule != "rules" {
+        t.Errorf("rule = %q, want %q", verr.Rule, "rules")
+    }
+}
+
+func TestWithMessageAndCode(t *testing.T) {
+    v := New()
+    v.Field("ab", "Password").MinRunes(8).WithMessage("Pick a longer password").WithCode("password_short")
+    verr := v.Validate(false)[0].(ValidationError)
+    if verr.Message != "Pick a longer password" || verr.Code != "password_short" {
+        t.Errorf("got message %q and code %q", verr.Message, verr.Code)
+    }
+
+    // Before any rule they do nothing, and the next rule is unaffected.
+    v = New()
+    f := v.Field("ab", "Password").WithMessage("ignored").WithCode("ignored")
+    if len(f.rules) != 0 {
+        t.Fatalf("WithMessage added %d rules", len(f.rules))
+    }
+    f.MinRunes(8)
+    verr = v.Validate(false)[0].(ValidationError)
+    if verr.Message != "Password must be at least 8 characters long" || verr.Code != "" {
+        t.Errorf("got message %q and code %q", verr.Message, verr.Code)
+    }
+}
+
+func TestWithMessageDoesNotLeak(t *testing.T) {
+    want := "Password must be at least 8 characters long"
+    base := New()
+    base.Field("ab", "Password").MinRunes(8)
+
+    clone := base.Clone()
+    clone.fields[0].WithMessage("Clone message").WithCode("clone")
+    merged := New().Merge(base)
+    merged.fields[0].WithMessage("Merged message").WithCode("merged")
+
+    for name, v := range map[string]*Validator{"clone": clone, "merged": merged, "base": base} {
+        verr := v.Validate(false)[0].(ValidationError)
+        switch name {
+        case "base":
+            if verr.Message != want || verr.Code != "" {
+                t.Errorf("base got message %q and code %q", verr.Message, verr.Code)
+            }
+        default:
+            if verr.Code != name {
+                t.Errorf("%s got code %q", name, verr.Code)
+            }
+        }
+    }
+
+    // Overriding the source afterwards doesn't reach the copies either.
+    base.fields[0].WithMessage("Base message")
+    if got := firstError(clone); got != "Clone message" {
+        t.Errorf("clone error %q after the base changed", got)
+    }
+    if got := firstError(merged); got != "Merged message" {
+        t.Errorf("merged error %q after the base changed", got)
+    }
+}
@@ -0,0 +1,137 @@
+// Package validatortest provides test assertions for code that validates
+// with the validator package, so tests can check "Email has a required
+// error" without walking the errors by hand.
+//
+// The assertions accept the []error returned by Validate, the *Result
+// returned by ValidateN and the other Result methods, or nil. Failures
+// list every error that was found.
+//
+// Example:
+//
+//    errs := validateSignup(req)
+//    validatortest.AssertErrorCount(t, errs, 2)
+//    validatortest.AssertFieldError(t, errs, "Email", "required")
+package validatortest
+
+import (
+    "errors"
+    "fmt"
+    "strings"
+    "testing"
+
+    validator "github.com/Olumuyiwaray/go-validator"
+)
+
+// AssertNoErrors fails the test when errs holds any error, or when the
+// *Result was aborted (see Result.Err).
+//
+// Example:
+//    validatortest.AssertNoErrors(t, v.Validate(false))
+func AssertNoErrors(t testing.TB, errs interface{}) {
+    t.Helper()
+    list, aborted := errorsOf(t, errs)
+    if aborted != nil {
+        t.Errorf("validation was aborted: %v", aborted)
+    }
+    if len(list) > 0 {
+        t.Errorf("expected no validation errors, got %s", describe(list))
+    }
+}
+
+// AssertErrorCount fails the test when errs does not hold exactly `want`
+// errors.
+//
+// Example:
+//    validatortest.AssertErrorCount(t, v.Validate(false), 3)
+func AssertErrorCount(t testing.TB, errs interface{}, want int) {
+    t.Helper()
+    list, aborted := errorsOf(t, errs)
+    if aborted != nil {
+        t.Errorf("validation was aborted: %v", aborted)
+    }
+    if len(list) != want {
+        t.Errorf("expected %d validation errors, got %s", want, describe(list))
+    }
+}
+
+// AssertFieldError fails the test unless errs holds an error for `field`
+// matching `want`. A validator.ValidationError matches when its Field is
+// `field` and `want` is its rule, such as "required", its code (see
+// Field.WithCode), or part of its message. Other errors match when their
+// message contains both `field` and `want`.
+//
+// Example:
+//    validatortest.AssertFieldError(t, errs, "Email", "required")
+//    validatortest.AssertFieldError(t, errs, "Password", "at least 8 characters")
+func AssertFieldError(t testing.TB, errs interface{}, field, want string) {
+    t.Helper()
+    list, aborted := errorsOf(t, errs)
+    if aborted != nil {
+        t.Errorf("validation was aborted: %v", aborted)
+        return
+    }
+    for _, err := range list {
+        if matches(err, field, want) {
+            return
+        }
+    }
+    t.Errorf("expected a validation error for %q matching %q, got %s", field, want, describe(list))
+}
+
+// errorsOf returns the errors held by errs, and the error that aborted
+// validation, if any.
+func errorsOf(t testing.TB, errs interface{}) ([]error, error) {
+    t.Helper()
+    switch errs := errs.(type) {
+    case nil:
+        return nil, nil
+    case []error:
+        return errs, nil
+    case *validator.Result:
+        if errs == nil {
+            return nil, nil
+        }
+        return errs.Errors(), errs.Err()
+    default:
+        t.Fatalf("validatortest: expected []error or *validator.Result, got %T", errs)
+        return nil, nil
+    }
+}
+
+// matches reports whether err is an error for field matching want.
+func matches(err error, field, want string) bool {
+    var verr validator.ValidationError
+    if errors.As(err, &verr) {
+        return verr.Field == field &&
+            (verr.Rule == want || (verr.Code != "" && verr.Code == want) || strings.Contains(verr.Message, want))
+    }
+    return strings.Contains(err.Error(), field) && strings.Contains(err.Error(), want)
+}
+
+// describe lists errs for failure messages, one per line, with the field,
+// rule and code of ValidationErrors.
+func describe(errs []error) string {
+    if len(errs) == 0 {
+        return "no errors"
+    }
+
+    var b strings.Builder
+    if len(errs) == 1 {
+        b.WriteString("1 error:")
+    } else {
+        fmt.Fprintf(&b, "%d errors:", len(errs))
+    }
+    for _, err := range errs {
+        var verr validator.ValidationError
+        if !errors.As(err, &verr) {
+            fmt.Fprintf(&b, "\n    %v", err)
+            continue
+        }
+        fmt.Fprintf(&b, "\n    %s [%s", verr.Field, verr.Rule)
+        if verr.Code != "" {
+            fmt.Fprintf(&b, ", code %s", verr.Code)
+        }
+        fmt.Fprintf(&b, "]: %s", verr.Message)
+    }
+    return b.String()
+}
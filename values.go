@@ -0,0 +1,91 @@
+package validator
+
+import (
+    "context"
+    "fmt"
+)
+
+// Set stores a value shared by the rules of v, such as the role of the
+// current user or the limits of a tenant, so rules can read it with Get
+// or RuleContext.Get instead of capturing variables. Clone copies the
+// values. Set must not be called while v is validating.
+//
+// Example:
+//
+//    v := base.Clone()
+//    v.Set("maxSeats", tenant.MaxSeats)
+func (v *Validator) Set(key string, value interface{}) *Validator {
+    owner := v.root()
+    if owner.values == nil {
+        owner.values = map[string]interface{}{}
+    }
+    owner.values[key] = value
+    owner.Invalidate()
+    return v
+}
+
+// Get returns the value stored with Set under `key`, or nil.
+//
+// Example:
+//
+//    role, _ := v.Get("role").(string)
+func (v *Validator) Get(key string) interface{} {
+    return v.root().values[key]
+}
+
+// RuleContext is passed to the functions of CustomContext. It tells which
+// field is being checked and gives access to the values stored with Set.
+type RuleContext struct {
+    // Field is the key of the field being checked (see ValidationError.Field).
+    Field string
+    // Name is the display name of the field, as used in messages.
+    Name string
+
+    validator *Validator
+}
+
+// Get returns the value stored with Validator.Set under `key`, or nil.
+func (c RuleContext) Get(key string) interface{} {
+    if c.validator == nil {
+        return nil
+    }
+    return c.validator.Get(key)
+}
+
+// Context returns the context passed to ValidateContext, or
+// context.Background() for the other Validate methods.
+func (c RuleContext) Context() context.Context {
+    return c.validator.context()
+}
+
+// CustomContext is Custom with access to the field being checked and to
+// the values stored with Validator.Set, so one rule function can be shared
+// between fields and still be configured per request.
+// Accepts an optional custom error message, used instead of the returned error.
+//
+// Example:
+//    b.Set("maxSeats", tenant.MaxSeats)
+//    b.Query("seats", "Seats").IntegerString().CustomContext(func(value interface{}, ctx validator.RuleContext) error {
+//        if max, _ := ctx.Get("maxSeats").(int); value.(int) > max {
+//            return fmt.Errorf("%s cannot be more than %d on your plan", ctx.Name, max)
+//        }
+//        return nil
+//    })
+func (f *Field) CustomContext(fn func(value interface{}, ctx RuleContext) error, messages ...string) *Field {
+    f.addRule("custom", nil, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        ctx := RuleContext{Field: f.fieldKey(), Name: f.name, validator: f.validator}
+        if err := fn(f.value, ctx); err != nil {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            return err
+        }
+        return nil
+    })
+    return f
+}
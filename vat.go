@@ -0,0 +1,260 @@
+package validator
+
+import (
+    "fmt"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// vatPatterns holds the structure of the number following the country
+// prefix of an EU VAT identification number. Greece uses the prefix "EL".
+var vatPatterns = map[string]*regexp.Regexp{
+    "AT": regexp.MustCompile(`^U\d{8}$`),
+    "BE": regexp.MustCompile(`^[01]\d{9}$`),
+    "BG": regexp.MustCompile(`^\d{9,10}$`),
+    "CY": regexp.MustCompile(`^\d{8}[A-Z]$`),
+    "CZ": regexp.MustCompile(`^\d{8,10}$`),
+    "DE": regexp.MustCompile(`^\d{9}$`),
+    "DK": regexp.MustCompile(`^\d{8}$`),
+    "EE": regexp.MustCompile(`^\d{9}$`),
+    "EL": regexp.MustCompile(`^\d{9}$`),
+    "ES": regexp.MustCompile(`^([A-Z]\d{7}[A-Z0-9]|\d{8}[A-Z])$`),
+    "FI": regexp.MustCompile(`^\d{8}$`),
+    "FR": regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}\d{9}$`),
+    "HR": regexp.MustCompile(`^\d{11}$`),
+    "HU": regexp.MustCompile(`^\d{8}$`),
+    "IE": regexp.MustCompile(`^(\d{7}[A-W][A-IW]?|\d[A-Z+*]\d{5}[A-W])$`),
+    "IT": regexp.MustCompile(`^\d{11}$`),
+    "LT": regexp.MustCompile(`^(\d{9}|\d{12})$`),
+    "LU": regexp.MustCompile(`^\d{8}$`),
+    "LV": regexp.MustCompile(`^\d{11}$`),
+    "MT": regexp.MustCompile(`^\d{8}$`),
+    "NL": regexp.MustCompile(`^\d{9}B\d{2}$`),
+    "PL": regexp.MustCompile(`^\d{10}$`),
+    "PT": regexp.MustCompile(`^\d{9}$`),
+    "RO": regexp.MustCompile(`^[1-9]\d{1,9}$`),
+    "SE": regexp.MustCompile(`^\d{10}01$`),
+    "SI": regexp.MustCompile(`^[1-9]\d{7}$`),
+    "SK": regexp.MustCompile(`^[1-9]\d{9}$`),
+}
+
+// vatChecksums holds the check digit algorithms of the countries that
+// publish one. Countries without an entry are checked by structure only.
+var vatChecksums = map[string]func(number string) bool{
+    "BE": vatChecksumBE,
+    "DE": vatChecksumDE,
+    "DK": vatChecksumDK,
+    "FI": vatChecksumFI,
+    "FR": vatChecksumFR,
+    "IT": luhnValid,
+    "NL": vatChecksumNL,
+    "PL": vatChecksumPL,
+    "PT": vatChecksumPT,
+    "SE": func(number string) bool { return luhnValid(number[:10]) },
+}
+
+// VAT validates that the value is an EU VAT identification number such as
+// "DE136695976" or "NL004495445B01": a member state prefix followed by a
+// number with that country's length and structure. Where a country
+// publishes a check digit algorithm (BE, DE, DK, FI, FR, IT, NL, PL, PT and
+// SE) the check digits are verified too. Spaces, dots and dashes are
+// ignored and letters may be lowercase. Placeholders that only have the
+// right shape fail their check digits: "DE123456789" should end in 8,
+// and "NL999999999B01" passes neither the MOD 11 nor the MOD 97 check of
+// Dutch numbers.
+//
+// This is a structural check only: it does not ask VIES whether the number
+// is actually registered.
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.VAT()
+//    f.VAT("Enter a valid EU VAT number")
+func (f *Field) VAT(messages ...string) *Field {
+    return f.VATForCountry("", messages...)
+}
+
+// VATForCountry is VAT limited to the member state `country`, such as the
+// billing country, given as its ISO 3166-1 alpha-2 code ("GR" and "EL" are
+// both accepted for Greece). An empty country accepts any member state,
+// and a country that is not a member state is a *ConfigError (see
+// Validator.Err).
+// Accepts an optional custom error message.
+//
+// Example:
+//    f.VATForCountry("DE")
+//    f.VATForCountry(billing.Country, "VAT number must match the billing country")
+func (f *Field) VATForCountry(country string, messages ...string) *Field {
+    country = strings.ToUpper(country)
+    if country == "GR" {
+        country = "EL"
+    }
+
+    f.addRule("vat", []interface{}{country}, func(f *Field) error {
+        message := ""
+        if len(messages) > 0 {
+            message = messages[0]
+        }
+
+        str, ok := f.value.(string)
+        if ok {
+            prefix, valid := parseVAT(str)
+            ok = valid && (country == "" || prefix == country)
+        }
+
+        if !ok {
+            if message != "" {
+                return fmt.Errorf("%s", message)
+            }
+            if country != "" {
+                return fmt.Errorf("%s must be a valid %s VAT number", f.name, country)
+            }
+            return fmt.Errorf("%s must be a valid VAT number", f.name)
+        }
+        return nil
+    })
+    var err error
+    if _, ok := vatPatterns[country]; country != "" && !ok {
+        err = fmt.Errorf("country %q is not an EU member state", country)
+    }
+    return f.misconfigured(err)
+}
+
+// parseVAT returns the country prefix of a VAT number, reporting whether
+// the number has the structure and check digits of that country.
+func parseVAT(str string) (string, bool) {
+    str = strings.ToUpper(strings.NewReplacer(" ", "", ".", "", "-", "").Replace(str))
+    if len(str) < 3 {
+        return "", false
+    }
+
+    prefix, number := str[:2], str[2:]
+    pattern, ok := vatPatterns[prefix]
+    if !ok || !pattern.MatchString(number) {
+        return prefix, false
+    }
+    if checksum, ok := vatChecksums[prefix]; ok && !checksum(number) {
+        return prefix, false
+    }
+    return prefix, true
+}
+
+// digitAt returns the value of the digit at index i of s.
+func digitAt(s string, i int) int {
+    return int(s[i] - '0')
+}
+
+// weightedSum returns the sum of the leading digits of s multiplied by
+// `weights`.
+func weightedSum(s string, weights ...int) int {
+    sum := 0
+    for i, weight := range weights {
+        sum += digitAt(s, i) * weight
+    }
+    return sum
+}
+
+// luhnValid reports whether the digits of s pass the Luhn check.
+func luhnValid(s string) bool {
+    sum := 0
+    for i := 0; i < len(s); i++ {
+        d := digitAt(s, len(s)-1-i)
+        if i%2 == 1 {
+            d *= 2
+            if d > 9 {
+                d -= 9
+            }
+        }
+        sum += d
+    }
+    return sum%10 == 0
+}
+
+// vatChecksumBE checks that the last two digits are 97 minus the first
+// eight modulo 97.
+func vatChecksumBE(number string) bool {
+    base, _ := strconv.Atoi(number[:8])
+    check, _ := strconv.Atoi(number[8:])
+    return 97-base%97 == check
+}
+
+// vatChecksumDE checks the ISO 7064 MOD 11,10 check digit.
+func vatChecksumDE(number string) bool {
+    product := 10
+    for i := 0; i < 8; i++ {
+        sum := (digitAt(number, i) + product) % 10
+        if sum == 0 {
+            sum = 10
+        }
+        product = 2 * sum % 11
+    }
+
+    check := 11 - product
+    if check == 10 {
+        check = 0
+    }
+    return check == digitAt(number, 8)
+}
+
+// vatChecksumDK checks that the weighted sum is divisible by 11.
+func vatChecksumDK(number string) bool {
+    return weightedSum(number, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+// vatChecksumFI checks the MOD 11 check digit in the last position.
+func vatChecksumFI(number string) bool {
+    check := 11 - weightedSum(number, 7, 9, 10, 5, 8, 4, 2)%11
+    if check == 11 {
+        check = 0
+    }
+    return check != 10 && check == digitAt(number, 7)
+}
+
+// vatChecksumFR checks the numeric key in front of the SIREN. Keys with
+// letters, used by some newer numbers, are checked by structure only.
+func vatChecksumFR(number string) bool {
+    key, err := strconv.Atoi(number[:2])
+    if err != nil {
+        return true
+    }
+    siren, _ := strconv.Atoi(number[2:])
+    return (12+3*(siren%97))%97 == key
+}
+
+// vatChecksumNL accepts either the MOD 11 check of the original numbers
+// or the MOD 97 check used for sole proprietors since 2020.
+func vatChecksumNL(number string) bool {
+    sum := weightedSum(number, 9, 8, 7, 6, 5, 4, 3, 2) - digitAt(number, 8)
+    if sum%11 == 0 {
+        return true
+    }
+
+    // ISO 7064 MOD 97-10 over "NL" + number, with N=23, L=21 and B=11.
+    remainder := 0
+    for _, c := range "NL" + number {
+        value := int(c - '0')
+        if c >= 'A' && c <= 'Z' {
+            value = int(c-'A') + 10
+        }
+        for _, digit := range strconv.Itoa(value) {
+            remainder = (remainder*10 + int(digit-'0')) % 97
+        }
+    }
+    return remainder == 1
+}
+
+// vatChecksumPL checks the MOD 11 check digit in the last position.
+func vatChecksumPL(number string) bool {
+    check := weightedSum(number, 6, 5, 7, 2, 3, 4, 5, 6, 7) % 11
+    return check != 10 && check == digitAt(number, 9)
+}
+
+// vatChecksumPT checks the MOD 11 check digit in the last position.
+func vatChecksumPT(number string) bool {
+    check := 11 - weightedSum(number, 9, 8, 7, 6, 5, 4, 3, 2)%11
+    if check >= 10 {
+        check = 0
+    }
+    return check == digitAt(number, 8)
+}
@@ -0,0 +1,65 @@
+package validator
+
+import (
+    "testing"
+)
+
+func TestVAT(t *testing.T) {
+    tests := []struct {
+        value string
+        valid bool
+    }{
+        {"DE136695976", true},
+        {"DE123456788", true},
+        {"de 136.695.976", true},
+        {"NL004495445B01", true},
+        {"NL000099998B57", true},
+        {"BE0403170701", true},
+        {"IT00743110157", true},
+        {"FR40303265045", true},
+        {"ATU13585627", true},
+        {"DK13585628", true},
+        {"FI20774740", true},
+        {"PL8567346215", true},
+        {"PT501964843", true},
+        {"SE556188840401", true},
+        {"ESA28015865", true},
+
+        // The examples of the request only have the right shape.
+        {"DE123456789", false},
+        {"NL999999999B01", false},
+        {"DE12345678", false},
+        {"NL004495445B1", false},
+        {"US123456789", false},
+        {"", false},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "VAT").VAT()
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("VAT(%q): valid = %v, want %v", tt.value, valid, tt.valid)
+        }
+    }
+}
+
+func TestVATForCountry(t *testing.T) {
+    tests := []struct {
+        country, value string
+        valid          bool
+    }{
+        {"DE", "DE136695976", true},
+        {"de", "DE136695976", true},
+        {"NL", "DE136695976", false},
+        {"GR", "EL094259216", true},
+        {"EL", "EL094259216", true},
+    }
+
+    for _, tt := range tests {
+        v := New()
+        v.Field(tt.value, "VAT").VATForCountry(tt.country)
+        if valid := v.Validate(false) == nil; valid != tt.valid {
+            t.Errorf("VATForCountry(%q) of %q: valid = %v, want %v", tt.country, tt.value, valid, tt.valid)
+        }
+    }
+}